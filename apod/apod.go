@@ -32,7 +32,11 @@ func New(ctx context.Context, path, cacheDir string, logger *log.ZapEventLogger)
 	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("cache_dir", cacheDir))
 	}
+	return NewWithRepository(path, cacheDir, repos, logger)
+}
 
+// NewWithRepository functions creates new APOD instance from file with opened database.
+func NewWithRepository(path, cacheDir string, repos *db.Repository, logger *log.ZapEventLogger) (*APOD, error) {
 	// read configuration file
 	if len(path) == 0 {
 		return fallthroughCfg(repos, logger), nil
@@ -99,6 +99,14 @@ func (cfg *APOD) LookupWithoutCache(ctx context.Context, date values.Date, utcFl
 	return res[0], nil
 }
 
+// AltText function returns alt text for APOD image (title and explanation).
+func AltText(data *nasaapod.Response) string {
+	if data == nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.Join([]string{data.Title, data.Explanation}, "\n\n"))
+}
+
 func MakeMessage(data *nasaapod.Response) string {
 	if data == nil {
 		return ""
@@ -42,9 +42,11 @@ func (cfg *APOD) saveDB(ctx context.Context) error {
 		data = append(data, exportToModel(d))
 	}
 	cfg.Logger().Debug("start saving data to database", zap.Any("data", data))
-	if err := cfg.repos.InsertAPODData(ctx, data); err != nil {
+	res, err := cfg.repos.UpsertAPODData(ctx, data)
+	if err != nil {
 		return errs.Wrap(err)
 	}
+	cfg.Logger().Info("saved APOD data", zap.Int("inserted", res.Inserted), zap.Int("updated", res.Updated), zap.Int("skipped", res.Skipped))
 	cfg.Logger().Debug("complete saving data to database", zap.Any("data", data))
 	return nil
 }
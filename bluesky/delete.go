@@ -0,0 +1,60 @@
+package bluesky
+
+import (
+	"context"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+)
+
+// DeletePost method deletes post record by record URI (at://...) or post URL.
+func (cfg *Bluesky) DeletePost(ctx context.Context, uri string) error {
+	if cfg == nil {
+		return errs.Wrap(ecode.ErrNullPointer, errs.WithContext("uri", uri))
+	}
+
+	// create/refresh session
+	if cfg.client == nil {
+		if err := cfg.CreateSession(ctx); err != nil {
+			return errs.Wrap(err, errs.WithContext("uri", uri))
+		}
+	}
+
+	did, collection, recordKey, err := cfg.parseRecordURI(ctx, uri)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	cfg.Logger().Debug("start deleting record", zap.String("did", did), zap.String("collection", collection), zap.String("record_key", recordKey))
+	if _, err := atproto.RepoDeleteRecord(ctx, cfg.client, &atproto.RepoDeleteRecord_Input{
+		Collection: collection,
+		Repo:       did,
+		Rkey:       recordKey,
+	}); err != nil {
+		return errs.Wrap(
+			err,
+			errs.WithContext("uri", uri),
+			errs.WithContext("did", did),
+			errs.WithContext("collection", collection),
+			errs.WithContext("record_key", recordKey),
+		)
+	}
+	cfg.Logger().Info("complete deleting record", zap.String("uri", uri))
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -3,8 +3,6 @@ package bluesky
 import (
 	"context"
 	"path/filepath"
-	"regexp"
-	"strings"
 	"time"
 
 	"github.com/bluesky-social/indigo/api/atproto"
@@ -14,6 +12,7 @@ import (
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/toolbox/ecode"
 	"github.com/goark/toolbox/images"
+	"github.com/goark/toolbox/poster"
 	"go.uber.org/zap"
 )
 
@@ -21,10 +20,15 @@ import (
 type Message struct {
 	Msg        string
 	ReplryTo   string
+	Quote      string
 	ImageFiles []string
+	ImageAlts  []string
+	Thread     bool
+	Posted     []string // URIs of records already posted in thread (resume posting from next chunk)
 }
 
 // PostMessage method posts message and image files to Bluesky.
+// If Thread flag is true and message is too long, posts the message as thread, and returns URI of the first post.
 func (cfg *Bluesky) PostMessage(ctx context.Context, msg *Message) (string, error) {
 	if cfg == nil {
 		return "", errs.Wrap(ecode.ErrNullPointer, errs.WithContext("msg", msg))
@@ -43,131 +47,214 @@ func (cfg *Bluesky) PostMessage(ctx context.Context, msg *Message) (string, erro
 	// reply
 	var reply *bsky.FeedPost_ReplyRef
 	if len(msg.ReplryTo) > 0 {
-		record, err := cfg.getRecord(ctx, msg.ReplryTo)
+		ref, err := cfg.replyRef(ctx, msg.ReplryTo)
 		if err != nil {
 			return "", errs.Wrap(err, errs.WithContext("msg", msg))
 		}
-		orig := record.Value.Val.(*bsky.FeedPost)
-		reply = &bsky.FeedPost_ReplyRef{
-			Root:   &atproto.RepoStrongRef{Cid: *record.Cid, Uri: record.Uri},
-			Parent: &atproto.RepoStrongRef{Cid: *record.Cid, Uri: record.Uri},
+		reply = ref
+	}
+
+	// quote
+	var quote *atproto.RepoStrongRef
+	if len(msg.Quote) > 0 {
+		record, err := cfg.getRecord(ctx, msg.Quote)
+		if err != nil {
+			return "", errs.Wrap(err, errs.WithContext("msg", msg))
 		}
-		if orig.Reply != nil && orig.Reply.Root != nil {
-			reply.Root = &atproto.RepoStrongRef{Cid: orig.Reply.Root.Cid, Uri: orig.Reply.Root.Uri}
+		quote = &atproto.RepoStrongRef{Cid: *record.Cid, Uri: record.Uri}
+	}
+
+	// split message
+	chunks := []string{msg.Msg}
+	if msg.Thread {
+		chunks = SplitText(msg.Msg, MaxGraphemes)
+		cfg.Logger().Debug("split message", zap.Int("chunks", len(chunks)))
+	}
+
+	// resume thread (next post replies to the last posted record)
+	uris := append([]string{}, msg.Posted...)
+	if len(uris) > 0 {
+		if len(uris) >= len(chunks) {
+			return uris[0], nil
 		}
+		ref, err := cfg.replyRef(ctx, uris[len(uris)-1])
+		if err != nil {
+			return "", errs.Wrap(&poster.ThreadError{Posted: uris, Err: err}, errs.WithContext("msg", msg))
+		}
+		reply = ref
+		cfg.Logger().Info("resume posting thread", zap.Strings("posted", uris))
 	}
 
-	// make post data (not support reply and quote)
+	for i := len(uris); i < len(chunks); i++ {
+		// make post data
+		post, err := cfg.makePost(ctx, chunks[i], reply, false)
+		if err != nil {
+			return "", errs.Wrap(err, errs.WithContext("msg", msg), errs.WithContext("chunk", i))
+		}
+		// embeded images (first post only)
+		if i == 0 && len(msg.ImageFiles) > 0 {
+			embed, err := cfg.uploadImages(ctx, msg.ImageFiles, msg.ImageAlts)
+			if err != nil {
+				return "", errs.Wrap(err, errs.WithContext("msg", msg))
+			}
+			if post.Embed == nil {
+				post.Embed = &bsky.FeedPost_Embed{}
+			}
+			post.Embed.EmbedImages = embed
+		}
+		// quoted record (first post only)
+		if i == 0 && quote != nil {
+			post.Embed = embedQuote(post.Embed, quote)
+		}
+		// pos message
+		resp, err := cfg.createPost(ctx, post)
+		if err != nil {
+			return "", errs.Wrap(&poster.ThreadError{Posted: uris, Err: err}, errs.WithContext("msg", msg), errs.WithContext("chunk", i))
+		}
+		uris = append(uris, resp.Uri)
+		// next post replies to this post
+		parent := &atproto.RepoStrongRef{Cid: resp.Cid, Uri: resp.Uri}
+		if reply == nil {
+			reply = &bsky.FeedPost_ReplyRef{Root: parent}
+		}
+		reply = &bsky.FeedPost_ReplyRef{Root: reply.Root, Parent: parent}
+	}
+	if len(uris) == 0 {
+		return "", errs.Wrap(ecode.ErrNoContent, errs.WithContext("msg", msg))
+	}
+	if len(uris) > 1 {
+		cfg.Logger().Info("complete posting thread", zap.Strings("uris", uris))
+	}
+	return uris[0], nil
+}
+
+// replyRef method returns reference of reply to the record of URI. Root of the reference is root of the thread.
+func (cfg *Bluesky) replyRef(ctx context.Context, uri string) (*bsky.FeedPost_ReplyRef, error) {
+	record, err := cfg.getRecord(ctx, uri)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	orig := record.Value.Val.(*bsky.FeedPost)
+	reply := &bsky.FeedPost_ReplyRef{
+		Root:   &atproto.RepoStrongRef{Cid: *record.Cid, Uri: record.Uri},
+		Parent: &atproto.RepoStrongRef{Cid: *record.Cid, Uri: record.Uri},
+	}
+	if orig.Reply != nil && orig.Reply.Root != nil {
+		reply.Root = &atproto.RepoStrongRef{Cid: orig.Reply.Root.Cid, Uri: orig.Reply.Root.Uri}
+	}
+	return reply, nil
+}
+
+// makePost method makes post data with facets (links, mentions, and tags) and external embed.
+// If dryRun flag is true, does not resolve mentions and does not upload thumbnail image.
+func (cfg *Bluesky) makePost(ctx context.Context, text string, reply *bsky.FeedPost_ReplyRef, dryRun bool) (*bsky.FeedPost, error) {
 	post := &bsky.FeedPost{
-		Text:      msg.Msg,
+		Text:      text,
 		CreatedAt: time.Now().UTC().Format(time.RFC3339),
 		Reply:     reply,
 	}
 
-	// add links metadata
-	for _, e := range getLinksFrom(msg.Msg) {
-		post.Facets = append(post.Facets, &bsky.RichtextFacet{
-			Features: []*bsky.RichtextFacet_Features_Elem{
-				{RichtextFacet_Link: &bsky.RichtextFacet_Link{Uri: e.text}},
-			},
-			Index: &bsky.RichtextFacet_ByteSlice{
-				ByteStart: e.start,
-				ByteEnd:   e.end,
-			},
-		})
-		if post.Embed == nil {
-			post.Embed = &bsky.FeedPost_Embed{}
-		}
-		if post.Embed.EmbedExternal == nil {
-			// get information of web page
-			if page, _, err := cfg.wcfg.GetWebpage(ctx, e.text); err != nil {
-				cfg.Logger().Info("cannot read web page", zap.Object("error", zapobject.New(errs.Wrap(err))), zap.String("web_page", e.text))
-			} else {
-				post.Embed.EmbedExternal = &bsky.EmbedExternal{
+	// add rich text facets (links, mentions, and tags)
+	facets, links := cfg.buildFacets(ctx, text, dryRun)
+	post.Facets = facets
+
+	// add external embed (first link only)
+	if len(links) > 0 {
+		// get information of web page
+		if page, _, err := cfg.wcfg.GetWebpage(ctx, links[0]); err != nil {
+			cfg.Logger().Info("cannot read web page", zap.Object("error", zapobject.New(errs.Wrap(err))), zap.String("web_page", links[0]))
+		} else {
+			post.Embed = &bsky.FeedPost_Embed{
+				EmbedExternal: &bsky.EmbedExternal{
 					External: &bsky.EmbedExternal_External{
 						Description: page.Description,
 						Title:       page.Title,
 						Uri:         page.URL,
 					},
-				}
-				cfg.Logger().Debug("web page info", zap.String("title", page.Title), zap.String("description", page.Description), zap.String("url", page.URL))
-				// get attention image
-				if len(page.ImageURL) > 0 {
-					if res, err := cfg.getEmbedImage(ctx, page.ImageURL); err != nil {
+				},
+			}
+			cfg.Logger().Debug("web page info", zap.String("title", page.Title), zap.String("description", page.Description), zap.String("url", page.URL))
+			// get attention image
+			if len(page.ImageURL) > 0 {
+				if dryRun {
+					if blob, err := previewEmbedImage(ctx, page.ImageURL); err != nil {
 						cfg.Logger().Info("cannot get embeded image", zap.Object("error", zapobject.New(errs.Wrap(err))), zap.String("image_url", page.ImageURL))
 					} else {
-						post.Embed.EmbedExternal.External.Thumb = res.Blob
-						cfg.Logger().Info("embeded image", zap.String("content_type", res.Blob.MimeType), zap.Int64("size", res.Blob.Size), zap.String("url", page.ImageURL))
+						post.Embed.EmbedExternal.External.Thumb = blob
 					}
+				} else if res, err := cfg.getEmbedImage(ctx, page.ImageURL); err != nil {
+					cfg.Logger().Info("cannot get embeded image", zap.Object("error", zapobject.New(errs.Wrap(err))), zap.String("image_url", page.ImageURL))
+				} else {
+					post.Embed.EmbedExternal.External.Thumb = res.Blob
+					cfg.Logger().Info("embeded image", zap.String("content_type", res.Blob.MimeType), zap.Int64("size", res.Blob.Size), zap.String("url", page.ImageURL))
 				}
 			}
 		}
 	}
+	return post, nil
+}
 
-	// add mentions metadata
-	for _, e := range getMentionsFrom(msg.Msg) {
-		prof, err := cfg.Profile(ctx, e.text)
-		if err != nil {
-			return "", errs.Wrap(err, errs.WithContext("msg", msg))
-		}
-		post.Facets = append(post.Facets, &bsky.RichtextFacet{
-			Features: []*bsky.RichtextFacet_Features_Elem{
-				{RichtextFacet_Mention: &bsky.RichtextFacet_Mention{Did: prof.Did}},
-			},
-			Index: &bsky.RichtextFacet_ByteSlice{
-				ByteStart: e.start,
-				ByteEnd:   e.end,
-			},
-		})
+// embedQuote function makes embed data with quoted record (app.bsky.embed.record).
+// If embed has images or external link, makes app.bsky.embed.recordWithMedia data.
+func embedQuote(embed *bsky.FeedPost_Embed, quote *atproto.RepoStrongRef) *bsky.FeedPost_Embed {
+	record := &bsky.EmbedRecord{Record: quote}
+	if embed == nil {
+		return &bsky.FeedPost_Embed{EmbedRecord: record}
 	}
-
-	// add tags metadata
-	for _, entry := range getTagsFrom(msg.Msg) {
-		post.Facets = append(post.Facets, &bsky.RichtextFacet{
-			Features: []*bsky.RichtextFacet_Features_Elem{
-				{RichtextFacet_Tag: &bsky.RichtextFacet_Tag{Tag: entry.text}},
-			},
-			Index: &bsky.RichtextFacet_ByteSlice{
-				ByteStart: entry.start,
-				ByteEnd:   entry.end,
-			},
-		})
+	media := &bsky.EmbedRecordWithMedia_Media{}
+	switch {
+	case embed.EmbedImages != nil:
+		media.EmbedImages = embed.EmbedImages
+	case embed.EmbedExternal != nil:
+		media.EmbedExternal = embed.EmbedExternal
+	default:
+		return &bsky.FeedPost_Embed{EmbedRecord: record}
 	}
+	return &bsky.FeedPost_Embed{
+		EmbedRecordWithMedia: &bsky.EmbedRecordWithMedia{
+			Media:  media,
+			Record: record,
+		},
+	}
+}
 
-	// embeded images
-	if len(msg.ImageFiles) > 0 {
-		for _, fn := range msg.ImageFiles {
-			var imgs []*bsky.EmbedImages_Image
-			src, err := images.FetchFromFile(fn)
-			if err != nil {
-				return "", errs.Wrap(err, errs.WithContext("file", fn))
-			}
-			img, err := images.AjustImage(src)
-			if err != nil {
-				err = errs.Wrap(err, errs.WithContext("file", fn))
-				cfg.Logger().Error("cannot ajust image", zap.Object("error", zapobject.New(err)), zap.String("file_name", fn))
-				return "", err
-			}
-			cfg.Logger().Debug("start uploading image file", zap.String("file_name", fn))
-			res, err := atproto.RepoUploadBlob(ctx, cfg.client, img)
-			if err != nil {
-				err = errs.Wrap(err, errs.WithContext("file", fn))
-				cfg.Logger().Error("cannot upload image file", zap.Object("error", zapobject.New(err)), zap.String("file_name", fn))
-				return "", err
-			}
-			imgs = append(imgs, &bsky.EmbedImages_Image{
-				Alt:   filepath.Base(fn),
-				Image: res.Blob,
-			})
-			if post.Embed == nil {
-				post.Embed = &bsky.FeedPost_Embed{}
-			}
-			cfg.Logger().Info("complete uploading image file", zap.String("content_type", res.Blob.MimeType), zap.Int64("size", res.Blob.Size), zap.String("file_name", fn))
-			post.Embed.EmbedImages = &bsky.EmbedImages{Images: imgs}
+// uploadImages method uploads image files, and returns embeded images data.
+// If no alt text for image file, uses file name instead.
+func (cfg *Bluesky) uploadImages(ctx context.Context, files, alts []string) (*bsky.EmbedImages, error) {
+	var imgs []*bsky.EmbedImages_Image
+	for i, fn := range files {
+		src, err := images.FetchFromFile(fn)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("file", fn))
 		}
+		img, err := images.AjustImage(src)
+		if err != nil {
+			err = errs.Wrap(err, errs.WithContext("file", fn))
+			cfg.Logger().Error("cannot ajust image", zap.Object("error", zapobject.New(err)), zap.String("file_name", fn))
+			return nil, err
+		}
+		cfg.Logger().Debug("start uploading image file", zap.String("file_name", fn))
+		res, err := atproto.RepoUploadBlob(ctx, cfg.client, img)
+		if err != nil {
+			err = errs.Wrap(err, errs.WithContext("file", fn))
+			cfg.Logger().Error("cannot upload image file", zap.Object("error", zapobject.New(err)), zap.String("file_name", fn))
+			return nil, err
+		}
+		alt := filepath.Base(fn)
+		if i < len(alts) && len(alts[i]) > 0 {
+			alt = alts[i]
+		}
+		imgs = append(imgs, &bsky.EmbedImages_Image{
+			Alt:   alt,
+			Image: res.Blob,
+		})
+		cfg.Logger().Info("complete uploading image file", zap.String("content_type", res.Blob.MimeType), zap.Int64("size", res.Blob.Size), zap.String("file_name", fn))
 	}
+	return &bsky.EmbedImages{Images: imgs}, nil
+}
 
-	// pos message
+// createPost method creates post record.
+func (cfg *Bluesky) createPost(ctx context.Context, post *bsky.FeedPost) (*atproto.RepoCreateRecord_Output, error) {
 	cfg.Logger().Debug("start posting message")
 	resp, err := atproto.RepoCreateRecord(ctx, cfg.client, &atproto.RepoCreateRecord_Input{
 		Collection: "app.bsky.feed.post",
@@ -177,11 +264,10 @@ func (cfg *Bluesky) PostMessage(ctx context.Context, msg *Message) (string, erro
 		},
 	})
 	if err != nil {
-		return "", errs.Wrap(err, errs.WithContext("msg", msg))
+		return nil, errs.Wrap(err)
 	}
 	cfg.Logger().Info("complete posting message", zap.Any("response_of_post", resp))
-
-	return resp.Uri, nil
+	return resp, nil
 }
 
 func (cfg *Bluesky) getEmbedImage(ctx context.Context, urlStr string) (*atproto.RepoUploadBlob_Output, error) {
@@ -201,57 +287,6 @@ func (cfg *Bluesky) getEmbedImage(ctx context.Context, urlStr string) (*atproto.
 	return res, nil
 }
 
-var (
-	urlRegexp     = regexp.MustCompile(`https?://[-A-Za-z0-9+&@#\/%?=~_|!:,.;\(\)]+`)
-	mentionRegexp = regexp.MustCompile(`@[a-zA-Z0-9.]+`)
-	tagsRegexp    = regexp.MustCompile(`\B#\S+`)
-)
-
-type entry struct {
-	start int64
-	end   int64
-	text  string
-}
-
-func getLinksFrom(msg string) []entry {
-	var result []entry
-	matches := urlRegexp.FindAllStringSubmatchIndex(msg, -1)
-	for _, m := range matches {
-		result = append(result, entry{
-			text:  msg[m[0]:m[1]],
-			start: int64(m[0]),
-			end:   int64(m[1]),
-		})
-	}
-	return result
-}
-
-func getMentionsFrom(msg string) []entry {
-	var result []entry
-	matches := mentionRegexp.FindAllStringSubmatchIndex(msg, -1)
-	for _, m := range matches {
-		result = append(result, entry{
-			text:  strings.TrimPrefix(msg[m[0]:m[1]], "@"),
-			start: int64(m[0]),
-			end:   int64(m[1]),
-		})
-	}
-	return result
-}
-
-func getTagsFrom(msg string) []entry {
-	var result []entry
-	matches := tagsRegexp.FindAllStringSubmatchIndex(msg, -1)
-	for _, m := range matches {
-		result = append(result, entry{
-			text:  strings.TrimPrefix(msg[m[0]:m[1]], "#"),
-			start: int64(len(msg[0:m[0]])),
-			end:   int64(len(msg[0:m[1]]))},
-		)
-	}
-	return result
-}
-
 /* Copyright 2023-2024 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,204 @@
+package bluesky
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/bluesky-social/indigo/api/atproto"
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/bluesky-social/indigo/lex/util"
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/images"
+	"github.com/goark/toolbox/poster"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
+)
+
+var _ poster.Previewer = (*Bluesky)(nil)
+
+// Preview method makes final payloads of neutral message without posting (poster.Previewer interface).
+func (cfg *Bluesky) Preview(ctx context.Context, msg *poster.Message) (*poster.Preview, error) {
+	posts, err := cfg.PreviewMessage(ctx, &Message{
+		Msg:        msg.Text,
+		ReplryTo:   msg.ReplyTo,
+		ImageFiles: msg.ImageFiles(),
+		ImageAlts:  msg.ImageAlts(),
+		Thread:     true,
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &poster.Preview{Name: cfg.Name(), Posts: posts}, nil
+}
+
+// PreviewMessage method makes post records (app.bsky.feed.post) of message without creating session and uploading blobs.
+// Mentions, reply and quoted records are not resolved, and blobs of images have CID computed locally.
+func (cfg *Bluesky) PreviewMessage(ctx context.Context, msg *Message) ([]*poster.PreviewPost, error) {
+	if cfg == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer, errs.WithContext("msg", msg))
+	}
+	if len(msg.Msg) == 0 {
+		return nil, errs.Wrap(ecode.ErrNoContent, errs.WithContext("msg", msg))
+	}
+
+	var reply *bsky.FeedPost_ReplyRef
+	if len(msg.ReplryTo) > 0 {
+		ref := &atproto.RepoStrongRef{Uri: msg.ReplryTo}
+		reply = &bsky.FeedPost_ReplyRef{Root: ref, Parent: ref}
+	}
+	chunks := []string{msg.Msg}
+	if msg.Thread {
+		chunks = SplitText(msg.Msg, MaxGraphemes)
+	}
+
+	posts := make([]*poster.PreviewPost, 0, len(chunks))
+	for i, chunk := range chunks {
+		post, err := cfg.makePost(ctx, chunk, reply, true)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("msg", msg), errs.WithContext("chunk", i))
+		}
+		pp := &poster.PreviewPost{
+			Text:      chunk,
+			Length:    GraphemeCount(chunk),
+			MaxLength: MaxGraphemes,
+		}
+		if i == 0 && len(msg.ImageFiles) > 0 {
+			embed, imgs, err := previewImages(msg.ImageFiles, msg.ImageAlts)
+			if err != nil {
+				return nil, errs.Wrap(err, errs.WithContext("msg", msg))
+			}
+			if post.Embed == nil {
+				post.Embed = &bsky.FeedPost_Embed{}
+			}
+			post.Embed.EmbedImages = embed
+			pp.Images = imgs
+		}
+		if i == 0 && len(msg.Quote) > 0 {
+			post.Embed = embedQuote(post.Embed, &atproto.RepoStrongRef{Uri: msg.Quote})
+		}
+		if post.Embed != nil && post.Embed.EmbedExternal != nil {
+			ext := post.Embed.EmbedExternal.External
+			pp.Details = append(pp.Details, "External: "+ext.Uri)
+			if ext.Thumb != nil {
+				pp.Details = append(pp.Details, fmt.Sprintf("Thumbnail: %s (%d bytes)", ext.Thumb.MimeType, ext.Thumb.Size))
+			}
+		}
+		for _, f := range post.Facets {
+			if d := facetDetail(f); len(d) > 0 {
+				pp.Details = append(pp.Details, d)
+			}
+		}
+		post.LexiconTypeID = "app.bsky.feed.post"
+		pp.Payload = post
+		posts = append(posts, pp)
+
+		// next post replies to previous post (URI is unknown until posting)
+		parent := &atproto.RepoStrongRef{Uri: fmt.Sprintf("(post %d)", i+1)}
+		if reply == nil {
+			reply = &bsky.FeedPost_ReplyRef{Root: parent}
+		}
+		reply = &bsky.FeedPost_ReplyRef{Root: reply.Root, Parent: parent}
+	}
+	return posts, nil
+}
+
+func facetDetail(f *bsky.RichtextFacet) string {
+	if f == nil || f.Index == nil || len(f.Features) == 0 {
+		return ""
+	}
+	elem := f.Features[0]
+	switch {
+	case elem.RichtextFacet_Link != nil:
+		return fmt.Sprintf("Facet: link [%d:%d] %s", f.Index.ByteStart, f.Index.ByteEnd, elem.RichtextFacet_Link.Uri)
+	case elem.RichtextFacet_Mention != nil:
+		return fmt.Sprintf("Facet: mention [%d:%d] %s", f.Index.ByteStart, f.Index.ByteEnd, elem.RichtextFacet_Mention.Did)
+	case elem.RichtextFacet_Tag != nil:
+		return fmt.Sprintf("Facet: tag [%d:%d] %s", f.Index.ByteStart, f.Index.ByteEnd, elem.RichtextFacet_Tag.Tag)
+	default:
+		return ""
+	}
+}
+
+// previewImages function makes embeded images data without uploading image files.
+func previewImages(files, alts []string) (*bsky.EmbedImages, []*poster.PreviewImage, error) {
+	var imgs []*bsky.EmbedImages_Image
+	var list []*poster.PreviewImage
+	for i, fn := range files {
+		src, err := images.FetchFromFile(fn)
+		if err != nil {
+			return nil, nil, errs.Wrap(err, errs.WithContext("file", fn))
+		}
+		blob, err := makeBlob(src)
+		if err != nil {
+			return nil, nil, errs.Wrap(err, errs.WithContext("file", fn))
+		}
+		alt := filepath.Base(fn)
+		if i < len(alts) && len(alts[i]) > 0 {
+			alt = alts[i]
+		}
+		imgs = append(imgs, &bsky.EmbedImages_Image{
+			Alt:   alt,
+			Image: blob,
+		})
+		list = append(list, &poster.PreviewImage{
+			Path:         fn,
+			Alt:          alt,
+			OriginalSize: len(src),
+			Size:         int(blob.Size),
+		})
+	}
+	return &bsky.EmbedImages{Images: imgs}, list, nil
+}
+
+// previewEmbedImage function makes blob data of thumbnail image without uploading.
+func previewEmbedImage(ctx context.Context, urlStr string) (*util.LexBlob, error) {
+	src, err := images.FetchFromURL(ctx, urlStr)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("url", urlStr))
+	}
+	blob, err := makeBlob(src)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("url", urlStr))
+	}
+	return blob, nil
+}
+
+// makeBlob function ajusts image data, and makes blob data with CID computed locally.
+func makeBlob(src []byte) (*util.LexBlob, error) {
+	r, err := images.AjustImage(src)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	c, err := cid.NewPrefixV1(cid.Raw, multihash.SHA2_256).Sum(b)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &util.LexBlob{
+		Ref:      util.LexLink(c),
+		MimeType: http.DetectContentType(b),
+		Size:     int64(len(b)),
+	}, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -11,13 +11,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func (cfg *Bluesky) getRecord(ctx context.Context, uri string) (*atproto.RepoGetRecord_Output, error) {
+// parseRecordURI method returns DID, collection and record key from record URI (at://...) or post URL (https://.../profile/{handle}/post/{recordKey}).
+func (cfg *Bluesky) parseRecordURI(ctx context.Context, uri string) (string, string, string, error) {
 	var did, collection, recordKey string
 	if strings.HasPrefix(uri, "at://") {
 		// record URI: at://{did}/{collection}/{recordKey}
 		parts := strings.Split(strings.TrimPrefix(uri, "at://"), "/")
 		if len(parts) != 3 {
-			return nil, errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "error in split of URL(at://~)"))
+			return "", "", "", errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "error in split of URL(at://~)"))
 		}
 		did = parts[0]
 		collection = parts[1]
@@ -26,26 +27,34 @@ func (cfg *Bluesky) getRecord(ctx context.Context, uri string) (*atproto.RepoGet
 		// maybe http(s)://{host}/profile/{handle}/post/{recordKey}
 		u, err := url.Parse(uri)
 		if err != nil {
-			return nil, errs.Wrap(err, errs.WithContext("uri", uri))
+			return "", "", "", errs.Wrap(err, errs.WithContext("uri", uri))
 		}
 		parts := strings.Split(u.Path, "/")
 		if len(parts) < 5 {
-			return nil, errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("parts", parts))
+			return "", "", "", errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("parts", parts))
 		}
 		if parts[1] != "profile" {
-			return nil, errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "parse error (profile)"))
+			return "", "", "", errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "parse error (profile)"))
 		}
 		p, err := cfg.Profile(ctx, parts[2])
 		if err != nil {
-			return nil, errs.Wrap(err, errs.WithContext("uri", uri), errs.WithContext("handle", parts[2]))
+			return "", "", "", errs.Wrap(err, errs.WithContext("uri", uri), errs.WithContext("handle", parts[2]))
 		}
 		did = p.Did
 		if parts[3] != "post" {
-			return nil, errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "parse error (post)"))
+			return "", "", "", errs.Wrap(ecode.ErrInvalidBlueskyRecordURI, errs.WithContext("uri", uri), errs.WithContext("detail", "parse error (post)"))
 		}
 		recordKey = parts[4]
 		collection = "app.bsky.feed.post"
 	}
+	return did, collection, recordKey, nil
+}
+
+func (cfg *Bluesky) getRecord(ctx context.Context, uri string) (*atproto.RepoGetRecord_Output, error) {
+	did, collection, recordKey, err := cfg.parseRecordURI(ctx, uri)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 	cfg.Logger().Debug("start getting record", zap.String("collection", collection), zap.String("record_key", recordKey))
 	record, err := atproto.RepoGetRecord(context.TODO(), cfg.client, "", collection, did, recordKey)
 	if err != nil {
@@ -0,0 +1,244 @@
+package bluesky
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/poster"
+	"go.uber.org/zap"
+)
+
+// FacetType is type of rich text facet.
+type FacetType int
+
+const (
+	FacetLink FacetType = iota + 1
+	FacetMention
+	FacetTag
+)
+
+func (t FacetType) String() string {
+	switch t {
+	case FacetLink:
+		return "link"
+	case FacetMention:
+		return "mention"
+	case FacetTag:
+		return "tag"
+	default:
+		return "unknown"
+	}
+}
+
+// Facet is rich text facet detected in text.
+// Start and End are UTF-8 byte offsets in text, and Value is URI (link), handle (mention) or tag name (tag).
+type Facet struct {
+	Type  FacetType
+	Start int64
+	End   int64
+	Value string
+}
+
+const (
+	maxHandleLength = 253
+	maxTagLength    = 64
+	// unresolvedDidPrefix is prefix of placeholder for DID of mention in dry run (not valid DID).
+	unresolvedDidPrefix = "unresolved:"
+)
+
+var (
+	handleRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+)
+
+// DetectFacets function detects links, mentions and tags in text, and returns facets ordered by position.
+//
+//   - Link: http(s) URL not preceded by ASCII letter or digit. Trailing punctuation and unbalanced parenthesis are trimmed.
+//   - Mention: "@handle" preceded by start of text, white space or "(". Handle must be valid as AT Protocol handle (not e-mail address).
+//   - Tag: "#tag" or "＃tag" preceded by start of text or white space. Trailing punctuation is trimmed, and tag must include non-numeric character.
+func DetectFacets(text string) []Facet {
+	facets := []Facet{}
+	prev := rune(-1) // start of text
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		var f *Facet
+		switch {
+		case (r == 'h' || r == 'H') && !isASCIIAlnum(prev):
+			f = detectLink(text, i)
+		case r == '@' && (prev < 0 || unicode.IsSpace(prev) || prev == '('):
+			f = detectMention(text, i)
+		case (r == '#' || r == '＃') && (prev < 0 || unicode.IsSpace(prev)):
+			f = detectTag(text, i, size)
+		}
+		if f != nil {
+			facets = append(facets, *f)
+			r, _ = utf8.DecodeLastRuneInString(text[:f.End])
+			prev = r
+			i = int(f.End)
+			continue
+		}
+		prev = r
+		i += size
+	}
+	return facets
+}
+
+func isASCIIAlnum(r rune) bool {
+	return ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9')
+}
+
+func isURLChar(r rune) bool {
+	return isASCIIAlnum(r) || strings.ContainsRune("-+&@#/%?=~_|!:,.;()[]*$'", r)
+}
+
+func detectLink(text string, start int) *Facet {
+	lower := strings.ToLower(text[start:min(len(text), start+8)])
+	if !strings.HasPrefix(lower, "http://") && !strings.HasPrefix(lower, "https://") {
+		return nil
+	}
+	end := start
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if !isURLChar(r) {
+			break
+		}
+		end += size
+	}
+	// trim trailing punctuation and unbalanced parenthesis
+	for end > start {
+		last := text[end-1]
+		if strings.IndexByte(".,;:!?'", last) >= 0 {
+			end--
+			continue
+		}
+		if last == ')' && strings.Count(text[start:end], "(") < strings.Count(text[start:end], ")") {
+			end--
+			continue
+		}
+		break
+	}
+	uri := text[start:end]
+	if u, err := url.Parse(uri); err != nil || len(u.Hostname()) == 0 {
+		return nil
+	}
+	return &Facet{Type: FacetLink, Start: int64(start), End: int64(end), Value: uri}
+}
+
+func detectMention(text string, start int) *Facet {
+	end := start + 1
+	for end < len(text) {
+		c := text[end]
+		if !isASCIIAlnum(rune(c)) && c != '.' && c != '-' {
+			break
+		}
+		end++
+	}
+	// trim trailing period and hyphen
+	for end > start+1 && (text[end-1] == '.' || text[end-1] == '-') {
+		end--
+	}
+	// "@foo@bar" (e-mail address or fediverse account) is not mention
+	if end < len(text) && text[end] == '@' {
+		return nil
+	}
+	handle := text[start+1 : end]
+	if len(handle) == 0 || len(handle) > maxHandleLength || !handleRegexp.MatchString(handle) {
+		return nil
+	}
+	return &Facet{Type: FacetMention, Start: int64(start), End: int64(end), Value: strings.ToLower(handle)}
+}
+
+func detectTag(text string, start, prefixSize int) *Facet {
+	end := start + prefixSize
+	for end < len(text) {
+		r, size := utf8.DecodeRuneInString(text[end:])
+		if unicode.IsSpace(r) || isZeroWidth(r) || ((r == '#' || r == '＃') && end > start+prefixSize) {
+			break
+		}
+		end += size
+	}
+	// trim trailing punctuation
+	for end > start+prefixSize {
+		r, size := utf8.DecodeLastRuneInString(text[:end])
+		if !unicode.IsPunct(r) {
+			break
+		}
+		end -= size
+	}
+	tag := text[start+prefixSize : end]
+	if len(tag) == 0 || strings.HasPrefix(tag, "\ufe0f") || poster.GraphemeCount(tag) > maxTagLength {
+		return nil
+	}
+	if strings.IndexFunc(tag, func(r rune) bool { return !unicode.IsDigit(r) && !unicode.IsPunct(r) }) < 0 {
+		return nil
+	}
+	return &Facet{Type: FacetTag, Start: int64(start), End: int64(end), Value: tag}
+}
+
+func isZeroWidth(r rune) bool {
+	switch r {
+	case '\u00ad', '\u2060', '\u200a', '\u200b', '\u200c', '\u200d', '\u20e2':
+		return true
+	}
+	return false
+}
+
+// buildFacets method makes rich text facets from text.
+// Mentions which cannot be resolved are skipped.
+// If dryRun flag is true, mentions are not resolved, and placeholder "unresolved:<handle>" is set to DID of mention.
+func (cfg *Bluesky) buildFacets(ctx context.Context, text string, dryRun bool) ([]*bsky.RichtextFacet, []string) {
+	var facets []*bsky.RichtextFacet
+	var links []string
+	for _, f := range DetectFacets(text) {
+		elem := &bsky.RichtextFacet_Features_Elem{}
+		switch f.Type {
+		case FacetLink:
+			elem.RichtextFacet_Link = &bsky.RichtextFacet_Link{Uri: f.Value}
+			links = append(links, f.Value)
+		case FacetMention:
+			if dryRun {
+				elem.RichtextFacet_Mention = &bsky.RichtextFacet_Mention{Did: unresolvedDidPrefix + f.Value}
+				break
+			}
+			prof, err := cfg.Profile(ctx, f.Value)
+			if err != nil {
+				cfg.Logger().Info("cannot resolve mention", zap.Object("error", zapobject.New(errs.Wrap(err))), zap.String("handle", f.Value))
+				continue
+			}
+			elem.RichtextFacet_Mention = &bsky.RichtextFacet_Mention{Did: prof.Did}
+		case FacetTag:
+			elem.RichtextFacet_Tag = &bsky.RichtextFacet_Tag{Tag: f.Value}
+		default:
+			continue
+		}
+		facets = append(facets, &bsky.RichtextFacet{
+			Features: []*bsky.RichtextFacet_Features_Elem{elem},
+			Index: &bsky.RichtextFacet_ByteSlice{
+				ByteStart: f.Start,
+				ByteEnd:   f.End,
+			},
+		})
+	}
+	return facets, links
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,128 @@
+package bluesky_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bluesky-social/indigo/api/bsky"
+	"github.com/goark/toolbox/bluesky"
+)
+
+func TestDetectFacets(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		facets []bluesky.Facet
+	}{
+		{name: "empty", text: "", facets: []bluesky.Facet{}},
+		{name: "plain", text: "hello world", facets: []bluesky.Facet{}},
+		{
+			name:   "link",
+			text:   "see https://example.com/foo.",
+			facets: []bluesky.Facet{{Type: bluesky.FacetLink, Start: 4, End: 27, Value: "https://example.com/foo"}},
+		},
+		{
+			name:   "link in parenthesis",
+			text:   "(https://example.com/wiki/Foo_(bar))",
+			facets: []bluesky.Facet{{Type: bluesky.FacetLink, Start: 1, End: 35, Value: "https://example.com/wiki/Foo_(bar)"}},
+		},
+		{
+			name:   "link in japanese",
+			text:   "詳細はこちら→https://example.com/。",
+			facets: []bluesky.Facet{{Type: bluesky.FacetLink, Start: 21, End: 41, Value: "https://example.com/"}},
+		},
+		{
+			name:   "mention",
+			text:   "hi @alice.bsky.social.",
+			facets: []bluesky.Facet{{Type: bluesky.FacetMention, Start: 3, End: 21, Value: "alice.bsky.social"}},
+		},
+		{name: "e-mail address", text: "mail to foo@example.com", facets: []bluesky.Facet{}},
+		{name: "fediverse account", text: "@foo@example.com", facets: []bluesky.Facet{}},
+		{name: "invalid handle", text: "@foo is not handle", facets: []bluesky.Facet{}},
+		{
+			name:   "mention in japanese",
+			text:   "こんにちは　@alice.bsky.social さん",
+			facets: []bluesky.Facet{{Type: bluesky.FacetMention, Start: 18, End: 36, Value: "alice.bsky.social"}},
+		},
+		{
+			name:   "tag",
+			text:   "#apod 2024-01-01",
+			facets: []bluesky.Facet{{Type: bluesky.FacetTag, Start: 0, End: 5, Value: "apod"}},
+		},
+		{
+			name:   "tag with trailing punctuation",
+			text:   "today's #apod!",
+			facets: []bluesky.Facet{{Type: bluesky.FacetTag, Start: 8, End: 13, Value: "apod"}},
+		},
+		{
+			name:   "japanese tag",
+			text:   "今日の天気　#天文。",
+			facets: []bluesky.Facet{{Type: bluesky.FacetTag, Start: 18, End: 25, Value: "天文"}},
+		},
+		{
+			name:   "full-width number sign",
+			text:   "＃天文 です",
+			facets: []bluesky.Facet{{Type: bluesky.FacetTag, Start: 0, End: 9, Value: "天文"}},
+		},
+		{name: "tag in word", text: "foo#bar", facets: []bluesky.Facet{}},
+		{name: "numeric tag", text: "#2024", facets: []bluesky.Facet{}},
+		{name: "keycap", text: "#️⃣", facets: []bluesky.Facet{}},
+		{
+			name: "mixed",
+			text: "#apod @alice.bsky.social https://example.com",
+			facets: []bluesky.Facet{
+				{Type: bluesky.FacetTag, Start: 0, End: 5, Value: "apod"},
+				{Type: bluesky.FacetMention, Start: 6, End: 24, Value: "alice.bsky.social"},
+				{Type: bluesky.FacetLink, Start: 25, End: 44, Value: "https://example.com"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		facets := bluesky.DetectFacets(tc.text)
+		if len(facets) != len(tc.facets) {
+			t.Errorf("[%s] DetectFacets() = %+v, want %+v.", tc.name, facets, tc.facets)
+			continue
+		}
+		for i := range facets {
+			if facets[i] != tc.facets[i] {
+				t.Errorf("[%s] DetectFacets()[%d] = %+v, want %+v.", tc.name, i, facets[i], tc.facets[i])
+			}
+			if got := tc.text[facets[i].Start:facets[i].End]; len(got) == 0 {
+				t.Errorf("[%s] DetectFacets()[%d] has empty range.", tc.name, i)
+			}
+		}
+	}
+}
+
+func TestPreviewMessageMention(t *testing.T) {
+	posts, err := (&bluesky.Bluesky{}).PreviewMessage(context.Background(), &bluesky.Message{Msg: "hello @alice.bsky.social"})
+	if err != nil {
+		t.Fatalf("Bluesky.PreviewMessage() error = \"%+v\", want nil.", err)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("Bluesky.PreviewMessage() = %v posts, want %v.", len(posts), 1)
+	}
+	post, ok := posts[0].Payload.(*bsky.FeedPost)
+	if !ok || len(post.Facets) != 1 || len(post.Facets[0].Features) != 1 || post.Facets[0].Features[0].RichtextFacet_Mention == nil {
+		t.Fatalf("Bluesky.PreviewMessage() payload = %+v, want one mention facet.", posts[0].Payload)
+	}
+	if did := post.Facets[0].Features[0].RichtextFacet_Mention.Did; did != "unresolved:alice.bsky.social" {
+		t.Errorf("Bluesky.PreviewMessage() mention DID = %q, want %q.", did, "unresolved:alice.bsky.social")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,40 @@
+package bluesky
+
+import (
+	"github.com/goark/toolbox/poster"
+)
+
+const (
+	// MaxGraphemes is maximum number of graphemes in a post.
+	MaxGraphemes = 300
+)
+
+// GraphemeCount function returns number of graphemes in text.
+func GraphemeCount(text string) int {
+	return poster.GraphemeCount(text)
+}
+
+// SplitText function splits text into chunks of up to max graphemes.
+// Text is split on line-breaking (word) boundaries, and links, mentions and tags are never split.
+func SplitText(text string, max int) []string {
+	protected := []poster.Span{}
+	for _, f := range DetectFacets(text) {
+		protected = append(protected, poster.Span{Start: int(f.Start), End: int(f.End)})
+	}
+	return poster.SplitText(text, max, GraphemeCount, protected)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,56 @@
+package bluesky_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/bluesky"
+)
+
+func TestSplitText(t *testing.T) {
+	testCases := []struct {
+		name   string
+		text   string
+		max    int
+		chunks []string
+		long   bool // chunk of link may exceed max
+	}{
+		{name: "empty", text: "  ", max: 10, chunks: []string{}},
+		{name: "short", text: "hello world", max: 300, chunks: []string{"hello world"}},
+		{name: "words", text: "hello world foo bar", max: 11, chunks: []string{"hello world", "foo bar"}},
+		{name: "link", text: "see https://example.com/foo/bar now", max: 30, chunks: []string{"see", "https://example.com/foo/bar", "now"}},
+		{name: "tag", text: "aaaa #apod bbbb", max: 6, chunks: []string{"aaaa", "#apod", "bbbb"}},
+		{name: "japanese", text: "今日は晴れです。明日は雨です。", max: 8, chunks: []string{"今日は晴れです。", "明日は雨です。"}},
+		{name: "emoji", text: "👍🏻👍🏻👍🏻👍🏻", max: 3, chunks: []string{"👍🏻👍🏻👍🏻", "👍🏻"}},
+		{name: "long word", text: "abcdefghij", max: 4, chunks: []string{"abcd", "efgh", "ij"}},
+		{name: "long link", text: "see https://example.com/foo/bar now", max: 10, chunks: []string{"see", "https://example.com/foo/bar", "now"}, long: true},
+		{name: "long mention", text: "cc @very-long-handle.bsky.social", max: 10, chunks: []string{"cc", "@very-long-handle.bsky.social"}, long: true},
+	}
+
+	for _, tc := range testCases {
+		chunks := bluesky.SplitText(tc.text, tc.max)
+		if strings.Join(chunks, "|") != strings.Join(tc.chunks, "|") || len(chunks) != len(tc.chunks) {
+			t.Errorf("[%s] SplitText() = %q, want %q.", tc.name, chunks, tc.chunks)
+		}
+		for _, c := range chunks {
+			if n := bluesky.GraphemeCount(c); n > tc.max && !tc.long {
+				t.Errorf("[%s] SplitText() chunk %q has %d graphemes, want <= %d.", tc.name, c, n, tc.max)
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
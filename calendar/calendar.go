@@ -158,6 +158,14 @@ func (cal *Config) GetEvents() ([]koyomi.Event, error) {
 	return k.Events(), nil
 }
 
+// Range method returns range of date as "start/end" string.
+func (cfg *Config) Range() string {
+	if cfg == nil {
+		return ""
+	}
+	return cfg.start.String() + "/" + cfg.end.String()
+}
+
 /* Copyright 2024 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
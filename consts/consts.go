@@ -4,6 +4,7 @@ const (
 	AppNameShort  = "toolbox"
 	AppName       = "github.com/goark/" + AppNameShort
 	RepositoryURL = "https://github.com/goark/toolbox"
+	UserAgent     = AppNameShort + " (+" + RepositoryURL + ")"
 )
 
 /* Copyright 2023 Spiegel
@@ -30,20 +30,34 @@ func (repos *Repository) FindAPODDataByDate(ctx context.Context, date string) (*
 	return &data, nil
 }
 
-// InsertAPODData method inserts APOD data to database.
-func (repos *Repository) InsertAPODData(ctx context.Context, data []model.ApodData) error {
+// InsertAPODData method inserts APOD data to database in one transaction. Data with existing date is skipped.
+func (repos *Repository) InsertAPODData(ctx context.Context, data []model.ApodData) (*SaveResult, error) {
 	if repos == nil {
-		return errs.Wrap(ecode.ErrNullPointer)
+		return nil, errs.Wrap(ecode.ErrNullPointer)
 	}
-	if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if t := tx.Create(data); t.Error != nil {
-			return errs.Wrap(t.Error)
-		}
-		return nil
-	}); err != nil {
-		return err
+	res, err := upsertRecords(ctx, repos.Db(), data, "date", apodKey, false)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	repos.Logger().Debug("insert APOD data", zap.Stringer("result", res))
+	return res, nil
+}
+
+// UpsertAPODData method inserts or updates (by date) APOD data in database in one transaction.
+func (repos *Repository) UpsertAPODData(ctx context.Context, data []model.ApodData) (*SaveResult, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	res, err := upsertRecords(ctx, repos.Db(), data, "date", apodKey, true)
+	if err != nil {
+		return nil, errs.Wrap(err)
 	}
-	return nil
+	repos.Logger().Debug("upsert APOD data", zap.Stringer("result", res))
+	return res, nil
+}
+
+func apodKey(data *model.ApodData) string {
+	return data.Date
 }
 
 /* Copyright 2023 Spiegel
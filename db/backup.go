@@ -0,0 +1,237 @@
+package db
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Names of tables for export/import.
+const (
+	BackupWebpage = "webpage"
+	BackupAPOD    = "apod"
+	BackupHistory = "history"
+)
+
+// BackupTables function returns list of table names for export/import.
+func BackupTables() []string {
+	return []string{BackupWebpage, BackupAPOD, BackupHistory}
+}
+
+// BackupRecord is one line in JSON Lines for export/import.
+type BackupRecord struct {
+	Table string          `json:"table"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// checkBackupTables function checks table names. If list is empty, returns all tables.
+func checkBackupTables(list []string) ([]string, error) {
+	if len(list) == 0 {
+		return BackupTables(), nil
+	}
+	names := make([]string, 0, len(list))
+	for _, name := range list {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.Contains(BackupTables(), name) {
+			return nil, errs.Wrap(ecode.ErrInvalidTable, errs.WithContext("table", name))
+		}
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Export method writes records in tables (webpage, apod and history) to w as JSON Lines.
+// It returns number of exported records.
+func (repos *Repository) Export(ctx context.Context, w io.Writer, tableList ...string) (int, error) {
+	if repos == nil {
+		return 0, errs.Wrap(ecode.ErrNullPointer)
+	}
+	names, err := checkBackupTables(tableList)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	enc := json.NewEncoder(w)
+	count := 0
+	for _, name := range names {
+		var n int
+		var err error
+		switch name {
+		case BackupWebpage:
+			n, err = exportRecords[model.Webpage](ctx, repos.Db(), enc, name)
+		case BackupAPOD:
+			n, err = exportRecords[model.ApodData](ctx, repos.Db(), enc, name)
+		case BackupHistory:
+			n, err = exportRecords[model.PostHistory](ctx, repos.Db(), enc, name)
+		}
+		if err != nil {
+			return count, errs.Wrap(err, errs.WithContext("table", name))
+		}
+		repos.Logger().Debug("export records", zap.String("table", name), zap.Int("count", n))
+		count += n
+	}
+	return count, nil
+}
+
+func exportRecords[T any](ctx context.Context, db *gorm.DB, enc *json.Encoder, name string) (int, error) {
+	count := 0
+	var list []T
+	if t := db.WithContext(ctx).Order("id").FindInBatches(&list, batchSize, func(tx *gorm.DB, batch int) error {
+		for _, data := range list {
+			b, err := json.Marshal(data)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			if err := enc.Encode(&BackupRecord{Table: name, Data: b}); err != nil {
+				return errs.Wrap(err)
+			}
+			count++
+		}
+		return nil
+	}); t.Error != nil {
+		return count, errs.Wrap(t.Error)
+	}
+	return count, nil
+}
+
+// Import method reads JSON Lines exported by Export method, and saves records in tables.
+// Webpage and APOD records are inserted or updated by unique key (URL and date), and history records
+// already saved are skipped. Records of other tables than tableList are skipped.
+func (repos *Repository) Import(ctx context.Context, r io.Reader, tableList ...string) (map[string]*SaveResult, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	names, err := checkBackupTables(tableList)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	results := map[string]*SaveResult{}
+	for _, name := range BackupTables() {
+		results[name] = &SaveResult{}
+	}
+	var (
+		pages     []model.Webpage
+		apods     []model.ApodData
+		histories []model.PostHistory
+	)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 {
+			continue
+		}
+		var rec BackupRecord
+		if err := json.Unmarshal([]byte(text), &rec); err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("line", line))
+		}
+		if !slices.Contains(names, rec.Table) {
+			if res, ok := results[rec.Table]; ok {
+				res.Skipped++
+				continue
+			}
+			return nil, errs.Wrap(ecode.ErrInvalidTable, errs.WithContext("table", rec.Table), errs.WithContext("line", line))
+		}
+		var err error
+		switch rec.Table {
+		case BackupWebpage:
+			pages, err = appendRecord(pages, rec.Data)
+		case BackupAPOD:
+			apods, err = appendRecord(apods, rec.Data)
+		case BackupHistory:
+			histories, err = appendRecord(histories, rec.Data)
+		}
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("line", line))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	if len(pages) > 0 {
+		res, err := repos.UpsertWebpages(ctx, pages)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		results[BackupWebpage].Add(res)
+	}
+	if len(apods) > 0 {
+		res, err := repos.UpsertAPODData(ctx, apods)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		results[BackupAPOD].Add(res)
+	}
+	if len(histories) > 0 {
+		res, err := repos.importPostHistories(ctx, histories)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		results[BackupHistory].Add(res)
+	}
+	repos.Logger().Info("import records", zap.Any("result", results))
+	return results, nil
+}
+
+// appendRecord function decodes record and appends it to list.
+func appendRecord[T any](list []T, data json.RawMessage) ([]T, error) {
+	var rec T
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return list, errs.Wrap(err)
+	}
+	return append(list, rec), nil
+}
+
+// importPostHistories method inserts post histories in one transaction.
+// History which has same destination, content hash and posted time is skipped.
+func (repos *Repository) importPostHistories(ctx context.Context, list []model.PostHistory) (*SaveResult, error) {
+	res := &SaveResult{}
+	if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, data := range list {
+			var count int64
+			if t := tx.Model(&model.PostHistory{}).Where("destination = ? AND content_hash = ? AND posted_at = ?", data.Destination, data.ContentHash, data.PostedAt).Count(&count); t.Error != nil {
+				return errs.Wrap(t.Error)
+			}
+			if count > 0 {
+				res.Skipped++
+				continue
+			}
+			// ID and deleted time are cleared, because history is saved as new one in this database
+			data.ID, data.DeletedAt = 0, gorm.DeletedAt{}
+			if t := tx.Create(&data); t.Error != nil {
+				return errs.Wrap(t.Error)
+			}
+			res.Inserted++
+		}
+		return nil
+	}); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return res, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
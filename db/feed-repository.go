@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FindFeedByURL method finds Feed data from database condition by url.
+func (repos *Repository) FindFeedByURL(ctx context.Context, url string) (*model.Feed, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var data model.Feed
+	tx := repos.Db().WithContext(ctx).Where(&model.Feed{URL: url}).First(&data)
+	if tx.Error != nil {
+		err := errs.Wrap(tx.Error, errs.WithContext("url", url))
+		if errs.Is(tx.Error, gorm.ErrRecordNotFound) {
+			repos.Logger().Debug("no record", zap.Object("error", zapobject.New(err)))
+			return nil, nil
+		}
+		return nil, err
+	}
+	repos.Logger().Debug("find data", zap.Any("data", data))
+	return &data, nil
+}
+
+// SaveFeeds method inserts or updates (by URL) Feed data in database in one transaction.
+func (repos *Repository) SaveFeeds(ctx context.Context, datalist []*model.Feed) (*SaveResult, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	records := make([]model.Feed, 0, len(datalist))
+	for _, data := range datalist {
+		if data == nil {
+			continue
+		}
+		record := *data
+		record.Model = gorm.Model{} // record is identified by URL
+		records = append(records, record)
+	}
+	res, err := upsertRecords(ctx, repos.Db(), records, "url", feedKey, true)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	repos.Logger().Debug("save feed data", zap.Stringer("result", res))
+	return res, nil
+}
+
+func feedKey(data *model.Feed) string {
+	return data.URL
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
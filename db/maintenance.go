@@ -0,0 +1,162 @@
+package db
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// tables is list of models in database (ordered by table name).
+var tables = []any{
+	&model.ApodData{},
+	&model.Feed{},
+	&model.PostHistory{},
+	&model.QueueItem{},
+	&model.Webpage{},
+}
+
+// TableStats is statistics of table.
+type TableStats struct {
+	Table   string `json:"table"`
+	Rows    int64  `json:"rows"`
+	Deleted int64  `json:"deleted"` // soft-deleted rows
+}
+
+// Stats is statistics of database.
+type Stats struct {
+	Path     string        `json:"path"`
+	FileSize int64         `json:"file_size"`
+	Version  int           `json:"schema_version"`
+	Tables   []*TableStats `json:"tables"`
+}
+
+// Stats method returns statistics of database: file size and number of rows in each table.
+func (repos *Repository) Stats(ctx context.Context) (*Stats, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	stats := &Stats{Path: repos.Path(), FileSize: fileSize(repos.Path())}
+	version, err := model.CurrentVersion(ctx, repos.Db())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	stats.Version = version
+
+	db := repos.Db().WithContext(ctx)
+	for _, m := range tables {
+		ts := &TableStats{Table: tableName(db, m)}
+		if t := db.Model(m).Count(&ts.Rows); t.Error != nil {
+			return nil, errs.Wrap(t.Error, errs.WithContext("table", ts.Table))
+		}
+		if t := db.Unscoped().Model(m).Where("deleted_at IS NOT NULL").Count(&ts.Deleted); t.Error != nil {
+			return nil, errs.Wrap(t.Error, errs.WithContext("table", ts.Table))
+		}
+		stats.Tables = append(stats.Tables, ts)
+	}
+	return stats, nil
+}
+
+// Vacuum method compacts database file, and returns file sizes before and after vacuum.
+func (repos *Repository) Vacuum(ctx context.Context) (int64, int64, error) {
+	if repos == nil {
+		return 0, 0, errs.Wrap(ecode.ErrNullPointer)
+	}
+	before := fileSize(repos.Path())
+	if t := repos.Db().WithContext(ctx).Exec("VACUUM"); t.Error != nil {
+		return 0, 0, errs.Wrap(t.Error, errs.WithContext("dbfile", repos.Path()))
+	}
+	after := fileSize(repos.Path())
+	repos.Logger().Info("vacuum database", zap.String("path", repos.Path()), zap.Int64("before", before), zap.Int64("after", after))
+	return before, after, nil
+}
+
+// PruneCondition is condition for pruning database.
+type PruneCondition struct {
+	Before  *time.Time // webpages fetched before this time are deleted
+	Deleted bool       // soft-deleted rows in all tables are deleted
+}
+
+// Kinds of pruned rows.
+const (
+	PruneOld     = "old"
+	PruneDeleted = "soft-deleted"
+)
+
+// PruneResult is number of deleted rows in table.
+type PruneResult struct {
+	Table string `json:"table"`
+	Kind  string `json:"kind"` // old or soft-deleted
+	Rows  int64  `json:"rows"`
+}
+
+// Prune method deletes permanently old webpages and/or soft-deleted rows in one transaction.
+func (repos *Repository) Prune(ctx context.Context, cond *PruneCondition) ([]*PruneResult, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if cond == nil || (cond.Before == nil && !cond.Deleted) {
+		return nil, errs.Wrap(ecode.ErrNoPruneCondition)
+	}
+	results := []*PruneResult{}
+	if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if cond.Before != nil {
+			// webpages saved by old version have no fetched_at (see model.Webpage.GetFetchedAt method)
+			t := tx.Unscoped().Where("fetched_at < ? OR (fetched_at IS NULL AND updated_at < ?)", *cond.Before, *cond.Before).Delete(&model.Webpage{})
+			if t.Error != nil {
+				return errs.Wrap(t.Error)
+			}
+			results = append(results, &PruneResult{Table: tableName(tx, &model.Webpage{}), Kind: PruneOld, Rows: t.RowsAffected})
+		}
+		if cond.Deleted {
+			for _, m := range tables {
+				t := tx.Unscoped().Where("deleted_at IS NOT NULL").Delete(m)
+				if t.Error != nil {
+					return errs.Wrap(t.Error)
+				}
+				results = append(results, &PruneResult{Table: tableName(tx, m), Kind: PruneDeleted, Rows: t.RowsAffected})
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	repos.Logger().Info("prune database", zap.Any("result", results))
+	return results, nil
+}
+
+func tableName(db *gorm.DB, m any) string {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(m); err != nil {
+		return ""
+	}
+	return stmt.Schema.Table
+}
+
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0
+	}
+	return info.Size()
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
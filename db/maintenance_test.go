@@ -0,0 +1,158 @@
+package db_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+)
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	repos := openRepository(t)
+	old := time.Now().AddDate(0, 0, -30)
+	pages := []model.Webpage{
+		{URL: "https://example.com/prune/old"},
+		{URL: "https://example.com/prune/new"},
+		{URL: "https://example.com/prune/deleted"},
+	}
+	pages[0].SetFetchedAt(&old)
+	if _, err := repos.UpsertWebpages(ctx, pages); err != nil {
+		t.Fatalf("UpsertWebpages() error = \"%+v\", want nil.", err)
+	}
+	if tx := repos.Db().Where("url = ?", "https://example.com/prune/deleted").Delete(&model.Webpage{}); tx.Error != nil {
+		t.Fatalf("Delete() error = \"%+v\", want nil.", tx.Error)
+	}
+	if stats, err := repos.Stats(ctx); err != nil {
+		t.Errorf("Stats() error = \"%+v\", want nil.", err)
+	} else if deleted := countOf(stats, "webpages").Deleted; deleted != 1 {
+		t.Errorf("Stats() deleted webpages = %v, want %v.", deleted, 1)
+	}
+
+	if _, err := repos.Prune(ctx, &db.PruneCondition{}); !errors.Is(err, ecode.ErrNoPruneCondition) {
+		t.Errorf("Prune() error = \"%+v\", want \"%+v\".", err, ecode.ErrNoPruneCondition)
+	}
+	before := time.Now().AddDate(0, 0, -7)
+	res, err := repos.Prune(ctx, &db.PruneCondition{Before: &before, Deleted: true})
+	if err != nil {
+		t.Fatalf("Prune() error = \"%+v\", want nil.", err)
+	}
+	if len(res) == 0 || res[0].Table != "webpages" || res[0].Kind != db.PruneOld || res[0].Rows != 1 {
+		t.Errorf("Prune() = %+v, want 1 old webpage.", res)
+	}
+	for _, tc := range []struct {
+		url  string
+		find bool
+	}{
+		{url: "https://example.com/prune/old", find: false},
+		{url: "https://example.com/prune/new", find: true},
+	} {
+		data, err := repos.FindWebpageByURL(ctx, tc.url)
+		if err != nil {
+			t.Errorf("FindWebpageByURL() error = \"%+v\", want nil.", err)
+		} else if (data != nil) != tc.find {
+			t.Errorf("FindWebpageByURL(%q) = %+v, want found = %v.", tc.url, data, tc.find)
+		}
+	}
+	stats, err := repos.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = \"%+v\", want nil.", err)
+	}
+	if deleted := countOf(stats, "webpages").Deleted; deleted != 0 {
+		t.Errorf("Stats() deleted webpages = %v, want %v.", deleted, 0)
+	}
+	if stats.Version != model.LatestVersion() {
+		t.Errorf("Stats() version = %v, want %v.", stats.Version, model.LatestVersion())
+	}
+
+	if _, after, err := repos.Vacuum(ctx); err != nil || after == 0 {
+		t.Errorf("Vacuum() = %v, %v, want file size.", after, err)
+	}
+}
+
+func TestExportImport(t *testing.T) {
+	ctx := context.Background()
+	repos := openRepository(t)
+	if _, err := repos.UpsertWebpages(ctx, []model.Webpage{{URL: "https://example.com/backup/1", Title: "backup"}}); err != nil {
+		t.Fatalf("UpsertWebpages() error = \"%+v\", want nil.", err)
+	}
+	if _, err := repos.UpsertAPODData(ctx, []model.ApodData{{Date: "2026-01-01", Title: "backup"}}); err != nil {
+		t.Fatalf("UpsertAPODData() error = \"%+v\", want nil.", err)
+	}
+	if err := repos.InsertPostHistory(ctx, &model.PostHistory{Destination: "bluesky", ContentHash: "hash", PostedAt: time.Now()}); err != nil {
+		t.Fatalf("InsertPostHistory() error = \"%+v\", want nil.", err)
+	}
+	stats, err := repos.Stats(ctx)
+	if err != nil {
+		t.Fatalf("Stats() error = \"%+v\", want nil.", err)
+	}
+	pages, apods, histories := countOf(stats, "webpages").Rows, countOf(stats, "apod_data").Rows, countOf(stats, "post_histories").Rows
+
+	if _, err := repos.Export(ctx, &bytes.Buffer{}, "unknown"); !errors.Is(err, ecode.ErrInvalidTable) {
+		t.Errorf("Export() error = \"%+v\", want \"%+v\".", err, ecode.ErrInvalidTable)
+	}
+	buf := &bytes.Buffer{}
+	count, err := repos.Export(ctx, buf)
+	if err != nil {
+		t.Fatalf("Export() error = \"%+v\", want nil.", err)
+	}
+	if lines := strings.Count(buf.String(), "\n"); int64(count) != pages+apods+histories || lines != count {
+		t.Errorf("Export() = %v records (%v lines), want %v.", count, lines, pages+apods+histories)
+	}
+
+	// import to database in which records are deleted
+	if tx := repos.Db().Unscoped().Where("url = ?", "https://example.com/backup/1").Delete(&model.Webpage{}); tx.Error != nil {
+		t.Fatalf("Delete() error = \"%+v\", want nil.", tx.Error)
+	}
+	res, err := repos.Import(ctx, bytes.NewReader(buf.Bytes()), db.BackupWebpage, db.BackupHistory)
+	if err != nil {
+		t.Fatalf("Import() error = \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		table string
+		want  db.SaveResult
+	}{
+		{table: db.BackupWebpage, want: db.SaveResult{Inserted: 1, Updated: int(pages) - 1}},
+		{table: db.BackupAPOD, want: db.SaveResult{Skipped: int(apods)}},
+		{table: db.BackupHistory, want: db.SaveResult{Skipped: int(histories)}},
+	}
+	for _, tc := range testCases {
+		if got := res[tc.table]; got == nil || *got != tc.want {
+			t.Errorf("Import() %s = %v, want %v.", tc.table, got, &tc.want)
+		}
+	}
+	data, err := repos.FindWebpageByURL(ctx, "https://example.com/backup/1")
+	if err != nil || data == nil || data.Title != "backup" {
+		t.Errorf("FindWebpageByURL() = %+v, %v, want imported record.", data, err)
+	}
+}
+
+func countOf(stats *db.Stats, table string) *db.TableStats {
+	for _, ts := range stats.Tables {
+		if ts.Table == table {
+			return ts
+		}
+	}
+	return &db.TableStats{}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Feed is state of Web feed.
+type Feed struct {
+	gorm.Model
+	URL           string `gorm:"unique"`
+	Title         string
+	ETag          string
+	LastModified  string
+	FetchedAt     sql.NullTime
+	LastGUID      string
+	LastPublished sql.NullTime
+	ErrorCount    int
+}
+
+// GetFetchedAt returns pointer of time.Time for Feed.FetchedAt.
+func (f Feed) GetFetchedAt() *time.Time {
+	if !f.FetchedAt.Valid {
+		return nil
+	}
+	tm := f.FetchedAt.Time
+	return &tm
+}
+
+// GetLastPublished returns pointer of time.Time for Feed.LastPublished.
+func (f Feed) GetLastPublished() *time.Time {
+	if !f.LastPublished.Valid {
+		return nil
+	}
+	tm := f.LastPublished.Time
+	return &tm
+}
+
+// SetLastPublished sets Feed.LastPublished.
+func (f *Feed) SetLastPublished(tm *time.Time) {
+	if tm != nil {
+		f.LastPublished = sql.NullTime{Time: *tm, Valid: true}
+	} else {
+		f.LastPublished = sql.NullTime{Time: time.Time{}, Valid: false}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -2,17 +2,169 @@ package model
 
 import (
 	"context"
+	"time"
 
 	"github.com/goark/errs"
 	"gorm.io/gorm"
 )
 
-func Migration(ctx context.Context, db *gorm.DB) error {
-	if err := db.WithContext(ctx).AutoMigrate(
-		&ApodData{},
-		&Webpage{},
-	); err != nil {
-		return errs.Wrap(err)
+// SchemaVersion is record of applied migration step.
+type SchemaVersion struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+// TableName method returns name of table for SchemaVersion.
+func (SchemaVersion) TableName() string {
+	return "schema_version"
+}
+
+// migrationStep is step of schema migration. Steps must be idempotent
+// because database created by old version (without schema_version table) runs all steps.
+type migrationStep struct {
+	version int
+	name    string
+	up      func(tx *gorm.DB) error
+}
+
+// migrationSteps is ordered list of migration steps. Append new step to the end of list when schema changes.
+// Schemas of tables in steps are frozen (see tableSchema type), do not change them after release.
+var migrationSteps = []migrationStep{
+	{version: 1, name: "create apod_data and webpages tables", up: func(tx *gorm.DB) error {
+		return createTables(tx, apodDataV1, webpagesV1)
+	}},
+	{version: 2, name: "create post_histories, queue_items and feeds tables, and add fetched_at column to webpages table", up: func(tx *gorm.DB) error {
+		return createTables(tx, webpagesV2, postHistoriesV2, queueItemsV2, feedsV2)
+	}},
+}
+
+// Schemas of version 1 (baseline, created before versioned migrations)
+var (
+	apodDataV1 = schema("apod_data", [][2]string{
+		{"date", "text"}, {"copyright", "text"}, {"explanation", "text"}, {"hd_url", "text"}, {"media_type", "text"},
+		{"service_version", "text"}, {"title", "text"}, {"url", "text"}, {"thumbnail_url", "text"},
+	}, []string{"date"})
+	webpagesV1 = schema("webpages", [][2]string{
+		{"url", "text"}, {"canonical", "text"}, {"title", "text"}, {"description", "text"}, {"image_url", "text"}, {"published", "datetime"},
+	}, []string{"url"})
+)
+
+// Schemas of version 2
+var (
+	webpagesV2      = schema("webpages", append(webpagesV1.columns[len(gormModelColumns):], [2]string{"fetched_at", "datetime"}), []string{"url"})
+	postHistoriesV2 = schema("post_histories", [][2]string{
+		{"destination", "text"}, {"uri", "text"}, {"source_type", "text"}, {"source", "text"}, {"content_hash", "text"}, {"text", "text"}, {"posted_at", "datetime"},
+	}, nil,
+		index{name: "idx_post_histories_content", columns: []string{"destination", "content_hash"}},
+		index{name: "idx_post_histories_posted_at", columns: []string{"posted_at"}},
+		index{name: "idx_post_histories_source_type", columns: []string{"source_type"}},
+	)
+	queueItemsV2 = schema("queue_items", [][2]string{
+		{"destination", "text"}, {"status", "text"}, {"text", "text"}, {"images", "text"}, {"source_type", "text"}, {"source", "text"},
+		{"visibility", "text"}, {"force", "numeric"}, {"not_before", "datetime"}, {"next_attempt", "datetime"}, {"attempts", "integer"},
+		{"last_error", "text"}, {"uri", "text"}, {"posted", "text"}, {"posted_at", "datetime"},
+	}, nil,
+		index{name: "idx_queue_items_destination", columns: []string{"destination"}},
+		index{name: "idx_queue_items_next_attempt", columns: []string{"next_attempt"}},
+		index{name: "idx_queue_items_status", columns: []string{"status"}},
+	)
+	feedsV2 = schema("feeds", [][2]string{
+		{"url", "text"}, {"title", "text"}, {"e_tag", "text"}, {"last_modified", "text"}, {"fetched_at", "datetime"},
+		{"last_guid", "text"}, {"last_published", "datetime"}, {"error_count", "integer"},
+	}, []string{"url"})
+)
+
+// LatestVersion function returns version of latest schema.
+func LatestVersion() int {
+	return migrationSteps[len(migrationSteps)-1].version
+}
+
+// MigrationStatus is status of migration step.
+type MigrationStatus struct {
+	Version   int        `json:"version"`
+	Name      string     `json:"name"`
+	Applied   bool       `json:"applied"`
+	AppliedAt *time.Time `json:"applied_at,omitempty"`
+}
+
+// Migration function applies pending migration steps in order. Each step is applied in one transaction with schema_version record.
+// It returns versions of applied steps.
+func Migration(ctx context.Context, db *gorm.DB) ([]int, error) {
+	db = db.WithContext(ctx)
+	if err := db.Migrator().AutoMigrate(&SchemaVersion{}); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	current, err := CurrentVersion(ctx, db)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	applied := []int{}
+	for _, step := range migrationSteps {
+		if step.version <= current {
+			continue
+		}
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			if err := step.up(tx); err != nil {
+				return errs.Wrap(err)
+			}
+			if t := tx.Create(&SchemaVersion{Version: step.version, Name: step.name, AppliedAt: time.Now()}); t.Error != nil {
+				return errs.Wrap(t.Error)
+			}
+			return nil
+		}); err != nil {
+			return applied, errs.Wrap(err, errs.WithContext("version", step.version), errs.WithContext("name", step.name))
+		}
+		applied = append(applied, step.version)
+	}
+	return applied, nil
+}
+
+// CurrentVersion function returns version of schema in database (0 if no migration step is applied).
+func CurrentVersion(ctx context.Context, db *gorm.DB) (int, error) {
+	db = db.WithContext(ctx)
+	if !db.Migrator().HasTable(&SchemaVersion{}) {
+		return 0, nil
+	}
+	var version int
+	if t := db.Model(&SchemaVersion{}).Select("COALESCE(MAX(version), 0)").Scan(&version); t.Error != nil {
+		return 0, errs.Wrap(t.Error)
+	}
+	return version, nil
+}
+
+// Status function returns status of all migration steps.
+func Status(ctx context.Context, db *gorm.DB) ([]*MigrationStatus, error) {
+	db = db.WithContext(ctx)
+	applied := map[int]SchemaVersion{}
+	if db.Migrator().HasTable(&SchemaVersion{}) {
+		var list []SchemaVersion
+		if t := db.Find(&list); t.Error != nil {
+			return nil, errs.Wrap(t.Error)
+		}
+		for _, v := range list {
+			applied[v.Version] = v
+		}
+	}
+	status := make([]*MigrationStatus, 0, len(migrationSteps))
+	for _, step := range migrationSteps {
+		st := &MigrationStatus{Version: step.version, Name: step.name}
+		if v, ok := applied[step.version]; ok {
+			tm := v.AppliedAt
+			st.Applied = true
+			st.AppliedAt = &tm
+		}
+		status = append(status, st)
+	}
+	return status, nil
+}
+
+// createTables function creates tables if not exist, and adds missing columns to existing tables.
+func createTables(tx *gorm.DB, tables ...*tableSchema) error {
+	for _, ts := range tables {
+		if err := ts.create(tx); err != nil {
+			return errs.Wrap(err)
+		}
 	}
 	return nil
 }
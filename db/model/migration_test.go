@@ -0,0 +1,211 @@
+package model_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/goark/toolbox/db/model"
+	"gorm.io/gorm"
+)
+
+// Fixtures in testdata are schemas of databases created by released versions of toolbox (before versioned migrations),
+// with one webpage record.
+func TestMigrationFromFixture(t *testing.T) {
+	ctx := context.Background()
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "legacy_*.sql"))
+	if err != nil || len(fixtures) == 0 {
+		t.Fatalf("filepath.Glob() = %v, %v, want fixtures.", fixtures, err)
+	}
+	all := []int{}
+	for v := 1; v <= model.LatestVersion(); v++ {
+		all = append(all, v)
+	}
+	for _, fixture := range fixtures {
+		name := filepath.Base(fixture)
+		db := openFixture(t, fixture)
+
+		current, err := model.CurrentVersion(ctx, db)
+		if err != nil {
+			t.Fatalf("%s: CurrentVersion() error = \"%+v\", want nil.", name, err)
+		}
+		if current != 0 {
+			t.Errorf("%s: CurrentVersion() = %v, want %v.", name, current, 0)
+		}
+
+		// upgrade (all steps are applied, and tables and columns already existing are kept)
+		applied, err := model.Migration(ctx, db)
+		if err != nil {
+			t.Fatalf("%s: Migration() error = \"%+v\", want nil.", name, err)
+		}
+		if !reflect.DeepEqual(applied, all) {
+			t.Errorf("%s: Migration() = %v, want %v.", name, applied, all)
+		}
+		if current, _ := model.CurrentVersion(ctx, db); current != model.LatestVersion() {
+			t.Errorf("%s: CurrentVersion() after migration = %v, want %v.", name, current, model.LatestVersion())
+		}
+		checkSchema(t, name, db)
+
+		// data in fixture is kept
+		var page model.Webpage
+		if tx := db.Where(&model.Webpage{URL: "https://example.com/"}).First(&page); tx.Error != nil || page.Title != "Example" {
+			t.Errorf("%s: data in fixture = %+v (error %v), want title %v.", name, page, tx.Error, "Example")
+		}
+
+		// migration again (no pending steps)
+		if applied, err := model.Migration(ctx, db); err != nil || len(applied) != 0 {
+			t.Errorf("%s: Migration() again = %v (error %v), want no steps.", name, applied, err)
+		}
+		status, err := model.Status(ctx, db)
+		if err != nil {
+			t.Fatalf("%s: Status() error = \"%+v\", want nil.", name, err)
+		}
+		for _, st := range status {
+			if !st.Applied || st.AppliedAt == nil {
+				t.Errorf("%s: Status() = %+v, want applied.", name, st)
+			}
+		}
+	}
+}
+
+func TestMigrationPendingStep(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db.sqlite")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = \"%+v\", want nil.", err)
+	}
+	if _, err := model.Migration(ctx, db); err != nil {
+		t.Fatalf("Migration() error = \"%+v\", want nil.", err)
+	}
+	// database of previous version (last step is not applied)
+	latest := model.LatestVersion()
+	if tx := db.Where("version = ?", latest).Delete(&model.SchemaVersion{}); tx.Error != nil {
+		t.Fatalf("Delete() error = \"%+v\", want nil.", tx.Error)
+	}
+	if err := db.Migrator().DropColumn(&model.Webpage{}, "FetchedAt"); err != nil {
+		t.Fatalf("DropColumn() error = \"%+v\", want nil.", err)
+	}
+
+	status, err := model.Status(ctx, db)
+	if err != nil {
+		t.Fatalf("Status() error = \"%+v\", want nil.", err)
+	}
+	for _, st := range status {
+		if st.Applied != (st.Version < latest) {
+			t.Errorf("Status() = %+v, want applied = %v.", st, st.Version < latest)
+		}
+	}
+	applied, err := model.Migration(ctx, db)
+	if err != nil {
+		t.Fatalf("Migration() error = \"%+v\", want nil.", err)
+	}
+	if !reflect.DeepEqual(applied, []int{latest}) {
+		t.Errorf("Migration() = %v, want %v.", applied, []int{latest})
+	}
+	checkSchema(t, "pending", db)
+}
+
+func TestMigrationNewDatabase(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db.sqlite")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = \"%+v\", want nil.", err)
+	}
+	status, err := model.Status(ctx, db)
+	if err != nil {
+		t.Fatalf("Status() error = \"%+v\", want nil.", err)
+	}
+	for _, st := range status {
+		if st.Applied {
+			t.Errorf("Status() = %+v, want not applied.", st)
+		}
+	}
+	applied, err := model.Migration(ctx, db)
+	if err != nil {
+		t.Fatalf("Migration() error = \"%+v\", want nil.", err)
+	}
+	if len(applied) != model.LatestVersion() {
+		t.Errorf("Migration() = %v, want %v steps.", applied, model.LatestVersion())
+	}
+	checkSchema(t, "new", db)
+}
+
+func openFixture(t *testing.T, path string) *gorm.DB {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("os.ReadFile() error = \"%+v\", want nil.", err)
+	}
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "db.sqlite")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = \"%+v\", want nil.", err)
+	}
+	for _, stmt := range strings.Split(string(b), ";\n") {
+		if stmt = strings.TrimSpace(stmt); len(stmt) == 0 {
+			continue
+		}
+		if tx := db.Exec(stmt); tx.Error != nil {
+			t.Fatalf("%s: db.Exec(%q) error = \"%+v\", want nil.", path, stmt, tx.Error)
+		}
+	}
+	return db
+}
+
+// checkSchema function checks that all tables and columns for models exist,
+// and that schema is not changed by auto migration of models (frozen schemas in migration steps match models).
+func checkSchema(t *testing.T, name string, db *gorm.DB) {
+	t.Helper()
+	migrator := db.Migrator()
+	models := []any{&model.ApodData{}, &model.Webpage{}, &model.PostHistory{}, &model.QueueItem{}, &model.Feed{}}
+	for _, m := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			t.Fatalf("%s: Statement.Parse() error = \"%+v\", want nil.", name, err)
+		}
+		if !migrator.HasTable(m) {
+			t.Errorf("%s: table %v does not exist.", name, stmt.Schema.Table)
+			continue
+		}
+		for _, field := range stmt.Schema.Fields {
+			if len(field.DBName) > 0 && !migrator.HasColumn(m, field.DBName) {
+				t.Errorf("%s: column %v.%v does not exist.", name, stmt.Schema.Table, field.DBName)
+			}
+		}
+	}
+	before := dumpSchema(t, db)
+	if err := migrator.AutoMigrate(models...); err != nil {
+		t.Fatalf("%s: AutoMigrate() error = \"%+v\", want nil.", name, err)
+	}
+	if after := dumpSchema(t, db); !reflect.DeepEqual(after, before) {
+		t.Errorf("%s: schema is changed by AutoMigrate() = %q, want %q (add migration step for changes of models).", name, after, before)
+	}
+}
+
+// dumpSchema function returns SQL statements of schema in database.
+func dumpSchema(t *testing.T, db *gorm.DB) []string {
+	t.Helper()
+	var list []string
+	if tx := db.Raw("SELECT sql FROM sqlite_master WHERE sql IS NOT NULL ORDER BY name").Scan(&list); tx.Error != nil {
+		t.Fatalf("dumpSchema() error = \"%+v\", want nil.", tx.Error)
+	}
+	return list
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PostHistory struct {
+	gorm.Model
+	Destination string `gorm:"index:idx_post_histories_content,priority:1"`
+	URI         string
+	SourceType  string `gorm:"index"`
+	Source      string
+	ContentHash string `gorm:"index:idx_post_histories_content,priority:2"`
+	Text        string
+	PostedAt    time.Time `gorm:"index"`
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
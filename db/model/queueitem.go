@@ -0,0 +1,42 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type QueueItem struct {
+	gorm.Model
+	Destination string `gorm:"index"`
+	Status      string `gorm:"index"`
+	Text        string
+	Images      string // JSON encoded list of image files
+	SourceType  string
+	Source      string
+	Visibility  string
+	Force       bool
+	NotBefore   time.Time
+	NextAttempt time.Time `gorm:"index"`
+	Attempts    int
+	LastError   string
+	URI         string
+	Posted      string // JSON encoded list of URIs of chunks posted in thread
+	PostedAt    sql.NullTime
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/goark/errs"
+	"gorm.io/gorm"
+)
+
+// tableSchema is frozen definition of table in migration step.
+// Migration steps must not depend on model structs, because later changes of structs would change what old steps create.
+type tableSchema struct {
+	name    string
+	columns [][2]string // name and type of columns (except id column)
+	unique  []string    // columns with unique constraint
+	indexes []index
+}
+
+// index is frozen definition of index in table.
+type index struct {
+	name    string
+	columns []string
+}
+
+// gormModelColumns is columns of gorm.Model (except id column).
+var gormModelColumns = [][2]string{{"created_at", "datetime"}, {"updated_at", "datetime"}, {"deleted_at", "datetime"}}
+
+// schema function makes tableSchema with columns of gorm.Model and index of deleted_at column.
+func schema(name string, columns [][2]string, unique []string, indexes ...index) *tableSchema {
+	return &tableSchema{
+		name:    name,
+		columns: append(append([][2]string{}, gormModelColumns...), columns...),
+		unique:  unique,
+		indexes: append([]index{{name: "idx_" + name + "_deleted_at", columns: []string{"deleted_at"}}}, indexes...),
+	}
+}
+
+// create method creates table if not exist. If table exists, adds missing columns to the table.
+// Indexes are created if not exist.
+func (ts *tableSchema) create(tx *gorm.DB) error {
+	migrator := tx.Migrator()
+	if !migrator.HasTable(ts.name) {
+		defs := []string{"`id` integer PRIMARY KEY AUTOINCREMENT"}
+		for _, col := range ts.columns {
+			defs = append(defs, fmt.Sprintf("`%s` %s", col[0], col[1]))
+		}
+		for _, col := range ts.unique {
+			defs = append(defs, fmt.Sprintf("CONSTRAINT `uni_%s_%s` UNIQUE (`%s`)", ts.name, col, col))
+		}
+		if t := tx.Exec(fmt.Sprintf("CREATE TABLE `%s` (%s)", ts.name, strings.Join(defs, ","))); t.Error != nil {
+			return errs.Wrap(t.Error, errs.WithContext("table", ts.name))
+		}
+	} else {
+		for _, col := range ts.columns {
+			if migrator.HasColumn(ts.name, col[0]) {
+				continue
+			}
+			if t := tx.Exec(fmt.Sprintf("ALTER TABLE `%s` ADD `%s` %s", ts.name, col[0], col[1])); t.Error != nil {
+				return errs.Wrap(t.Error, errs.WithContext("table", ts.name), errs.WithContext("column", col[0]))
+			}
+		}
+	}
+	for _, idx := range ts.indexes {
+		if t := tx.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS `%s` ON `%s`(`%s`)", idx.name, ts.name, strings.Join(idx.columns, "`,`"))); t.Error != nil {
+			return errs.Wrap(t.Error, errs.WithContext("table", ts.name), errs.WithContext("index", idx.name))
+		}
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,6 @@
+-- schema of database created before versioned migrations: apod_data and webpages tables
+CREATE TABLE `apod_data` (`id` integer PRIMARY KEY AUTOINCREMENT,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`date` text,`copyright` text,`explanation` text,`hd_url` text,`media_type` text,`service_version` text,`title` text,`url` text,`thumbnail_url` text,CONSTRAINT `uni_apod_data_date` UNIQUE (`date`));
+CREATE INDEX `idx_apod_data_deleted_at` ON `apod_data`(`deleted_at`);
+CREATE TABLE `webpages` (`id` integer PRIMARY KEY AUTOINCREMENT,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`url` text,`canonical` text,`title` text,`description` text,`image_url` text,`published` datetime,CONSTRAINT `uni_webpages_url` UNIQUE (`url`));
+CREATE INDEX `idx_webpages_deleted_at` ON `webpages`(`deleted_at`);
+INSERT INTO `webpages` (`created_at`,`updated_at`,`url`,`title`) VALUES ('2023-01-02 03:04:05+00:00','2023-01-02 03:04:05+00:00','https://example.com/','Example');
@@ -15,6 +15,7 @@ type Webpage struct {
 	Description string
 	ImageURL    string
 	Published   sql.NullTime
+	FetchedAt   sql.NullTime
 }
 
 // GetPublished returns pointer of time.Time for Webpage.Published.
@@ -34,6 +35,29 @@ func (wp *Webpage) SetPublished(tm *time.Time) {
 	}
 }
 
+// GetFetchedAt returns pointer of time.Time for Webpage.FetchedAt.
+// If FetchedAt is null (data saved by old version), returns time of updating record.
+func (wp Webpage) GetFetchedAt() *time.Time {
+	if !wp.FetchedAt.Valid {
+		if wp.UpdatedAt.IsZero() {
+			return nil
+		}
+		tm := wp.UpdatedAt
+		return &tm
+	}
+	tm := wp.FetchedAt.Time
+	return &tm
+}
+
+// SetFetchedAt sets Webpage.FetchedAt.
+func (wp *Webpage) SetFetchedAt(tm *time.Time) {
+	if tm != nil {
+		wp.FetchedAt = sql.NullTime{Time: *tm, Valid: true}
+	} else {
+		wp.FetchedAt = sql.NullTime{Time: time.Time{}, Valid: false}
+	}
+}
+
 /* Copyright 2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// FindPostHistory method finds the latest PostHistory data from database condition by destination and content hash.
+func (repos *Repository) FindPostHistory(ctx context.Context, destination, contentHash string) (*model.PostHistory, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var data model.PostHistory
+	tx := repos.Db().WithContext(ctx).Where(&model.PostHistory{Destination: destination, ContentHash: contentHash}).Order("posted_at desc").First(&data)
+	if tx.Error != nil {
+		err := errs.Wrap(tx.Error, errs.WithContext("destination", destination), errs.WithContext("content_hash", contentHash))
+		if errs.Is(tx.Error, gorm.ErrRecordNotFound) {
+			repos.Logger().Debug("no record", zap.Object("error", zapobject.New(err)))
+			return nil, nil
+		}
+		return nil, err
+	}
+	repos.Logger().Debug("find data", zap.Any("data", data))
+	return &data, nil
+}
+
+// InsertPostHistory method inserts PostHistory data to database.
+func (repos *Repository) InsertPostHistory(ctx context.Context, data *model.PostHistory) error {
+	if repos == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if t := tx.Create(data); t.Error != nil {
+			return errs.Wrap(t.Error)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PostHistoryCondition is condition for finding PostHistory data.
+// Empty fields are ignored. Destination is compared case-insensitively, and Text is searched in text and source.
+type PostHistoryCondition struct {
+	Destination string
+	SourceType  string
+	Text        string
+	Since       time.Time // inclusive
+	Until       time.Time // exclusive
+	Limit       int
+}
+
+// FindPostHistories method finds PostHistory data from database by condition, ordered by newest first.
+func (repos *Repository) FindPostHistories(ctx context.Context, cond *PostHistoryCondition) ([]model.PostHistory, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	tx := repos.Db().WithContext(ctx).Model(&model.PostHistory{})
+	if cond != nil {
+		if len(cond.Destination) > 0 {
+			tx = tx.Where("LOWER(destination) = LOWER(?)", cond.Destination)
+		}
+		if len(cond.SourceType) > 0 {
+			tx = tx.Where("source_type = ?", cond.SourceType)
+		}
+		if len(cond.Text) > 0 {
+			pattern := "%" + cond.Text + "%"
+			tx = tx.Where("text LIKE ? OR source LIKE ?", pattern, pattern)
+		}
+		if !cond.Since.IsZero() {
+			tx = tx.Where("posted_at >= ?", cond.Since)
+		}
+		if !cond.Until.IsZero() {
+			tx = tx.Where("posted_at < ?", cond.Until)
+		}
+		if cond.Limit > 0 {
+			tx = tx.Limit(cond.Limit)
+		}
+	}
+	var list []model.PostHistory
+	if tx = tx.Order("posted_at desc").Find(&list); tx.Error != nil {
+		return nil, errs.Wrap(tx.Error, errs.WithContext("condition", cond))
+	}
+	repos.Logger().Debug("find data", zap.Int("count", len(list)), zap.Any("condition", cond))
+	return list, nil
+}
+
+// FindPostHistoryByID method finds PostHistory data from database by ID.
+func (repos *Repository) FindPostHistoryByID(ctx context.Context, id uint) (*model.PostHistory, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var data model.PostHistory
+	tx := repos.Db().WithContext(ctx).First(&data, id)
+	if tx.Error != nil {
+		err := errs.Wrap(tx.Error, errs.WithContext("id", id))
+		if errs.Is(tx.Error, gorm.ErrRecordNotFound) {
+			repos.Logger().Debug("no record", zap.Object("error", zapobject.New(err)))
+			return nil, nil
+		}
+		return nil, err
+	}
+	repos.Logger().Debug("find data", zap.Any("data", data))
+	return &data, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// InsertQueueItems method inserts QueueItem data to database.
+func (repos *Repository) InsertQueueItems(ctx context.Context, data []*model.QueueItem) error {
+	if repos == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if t := tx.Create(data); t.Error != nil {
+			return errs.Wrap(t.Error)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindDueQueueItems method finds QueueItem data with status and next attempt time before now, ordered by oldest first.
+func (repos *Repository) FindDueQueueItems(ctx context.Context, status string, now time.Time) ([]*model.QueueItem, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var list []*model.QueueItem
+	tx := repos.Db().WithContext(ctx).Where("status = ? AND next_attempt <= ?", status, now).Order("next_attempt asc").Order("id asc").Find(&list)
+	if tx.Error != nil {
+		return nil, errs.Wrap(tx.Error, errs.WithContext("status", status), errs.WithContext("now", now))
+	}
+	repos.Logger().Debug("find data", zap.Int("count", len(list)), zap.String("status", status))
+	return list, nil
+}
+
+// FindQueueItems method finds QueueItem data by status (all items if status is empty), ordered by newest first.
+func (repos *Repository) FindQueueItems(ctx context.Context, status string) ([]*model.QueueItem, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	tx := repos.Db().WithContext(ctx)
+	if len(status) > 0 {
+		tx = tx.Where(&model.QueueItem{Status: status})
+	}
+	var list []*model.QueueItem
+	if tx = tx.Order("id desc").Find(&list); tx.Error != nil {
+		return nil, errs.Wrap(tx.Error, errs.WithContext("status", status))
+	}
+	repos.Logger().Debug("find data", zap.Int("count", len(list)), zap.String("status", status))
+	return list, nil
+}
+
+// FindQueueItemByID method finds QueueItem data by ID.
+func (repos *Repository) FindQueueItemByID(ctx context.Context, id uint) (*model.QueueItem, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	var data model.QueueItem
+	tx := repos.Db().WithContext(ctx).First(&data, id)
+	if tx.Error != nil {
+		err := errs.Wrap(tx.Error, errs.WithContext("id", id))
+		if errs.Is(tx.Error, gorm.ErrRecordNotFound) {
+			repos.Logger().Debug("no record", zap.Object("error", zapobject.New(err)))
+			return nil, nil
+		}
+		return nil, err
+	}
+	repos.Logger().Debug("find data", zap.Any("data", data))
+	return &data, nil
+}
+
+// ClaimQueueItem method changes status of QueueItem data from "from" status to "to" status atomically.
+// It returns false if the data is not in "from" status (e.g. claimed by other process).
+func (repos *Repository) ClaimQueueItem(ctx context.Context, id uint, from, to string) (bool, error) {
+	if repos == nil {
+		return false, errs.Wrap(ecode.ErrNullPointer)
+	}
+	tx := repos.Db().WithContext(ctx).Model(&model.QueueItem{}).Where("id = ? AND status = ?", id, from).Update("status", to)
+	if tx.Error != nil {
+		return false, errs.Wrap(tx.Error, errs.WithContext("id", id), errs.WithContext("from", from), errs.WithContext("to", to))
+	}
+	return tx.RowsAffected > 0, nil
+}
+
+// UpdateQueueItem method updates QueueItem data in database.
+func (repos *Repository) UpdateQueueItem(ctx context.Context, data *model.QueueItem) error {
+	if repos == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if tx := repos.Db().WithContext(ctx).Save(data); tx.Error != nil {
+		return errs.Wrap(tx.Error, errs.WithContext("id", data.ID))
+	}
+	return nil
+}
+
+// DeleteQueueItem method deletes QueueItem data from database.
+func (repos *Repository) DeleteQueueItem(ctx context.Context, id uint) error {
+	if repos == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if tx := repos.Db().WithContext(ctx).Unscoped().Delete(&model.QueueItem{}, id); tx.Error != nil {
+		return errs.Wrap(tx.Error, errs.WithContext("id", id))
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
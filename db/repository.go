@@ -8,6 +8,7 @@ import (
 	"github.com/goark/errs"
 	"github.com/goark/toolbox/db/conn"
 	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
 	"github.com/goark/toolbox/logger"
 	"github.com/ipfs/go-log/v2"
 	"go.uber.org/zap"
@@ -28,10 +29,29 @@ func existFile(dir string) (string, bool) {
 
 type Repository struct {
 	db     *gorm.DB
+	path   string
 	logger *log.ZapEventLogger
 }
 
+// Open function opens database in dir, and applies pending schema migrations.
 func Open(ctx context.Context, dir string, zlogger *log.ZapEventLogger) (*Repository, error) {
+	repos, err := OpenWithoutMigration(ctx, dir, zlogger)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	// migration (pending steps only)
+	applied, err := model.Migration(ctx, repos.Db())
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("dbfile", repos.Path()))
+	}
+	if len(applied) > 0 {
+		zlogger.Desugar().Info("complete migration", zap.String("path", repos.Path()), zap.Ints("applied", applied))
+	}
+	return repos, nil
+}
+
+// OpenWithoutMigration function opens database in dir without schema migrations (for checking status of migrations).
+func OpenWithoutMigration(ctx context.Context, dir string, zlogger *log.ZapEventLogger) (*Repository, error) {
 	// path of SQLite file
 	path, existFlag := existFile(dir)
 	zlogger.Desugar().Debug("database file", zap.String("path", path), zap.Bool("file exist", existFlag))
@@ -41,16 +61,7 @@ func Open(ctx context.Context, dir string, zlogger *log.ZapEventLogger) (*Reposi
 		return nil, errs.Wrap(err, errs.WithContext("dbfile", path))
 	}
 	zlogger.Desugar().Debug("complete opening database file", zap.String("path", path))
-	// migration
-	if !existFlag {
-		zlogger.Desugar().Debug("start migration", zap.String("path", path), zap.Bool("file exist", existFlag))
-		if err := model.Migration(ctx, db); err != nil {
-			return nil, errs.Wrap(err, errs.WithContext("dbfile", path))
-		}
-		zlogger.Desugar().Debug("complete migration", zap.String("path", path), zap.Bool("file exist", existFlag))
-	}
-
-	return &Repository{db: db, logger: zlogger}, nil
+	return &Repository{db: db, path: path, logger: zlogger}, nil
 }
 
 // Db method returns gorm.DB instance.
@@ -61,6 +72,38 @@ func (cfg *Repository) Db() *gorm.DB {
 	return cfg.db
 }
 
+// Path method returns path of database file.
+func (cfg *Repository) Path() string {
+	if cfg == nil {
+		return ""
+	}
+	return cfg.path
+}
+
+// MigrationStatus method returns status of schema migration steps.
+func (cfg *Repository) MigrationStatus(ctx context.Context) ([]*model.MigrationStatus, error) {
+	if cfg == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	status, err := model.Status(ctx, cfg.Db())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return status, nil
+}
+
+// Migrate method applies pending schema migration steps, and returns versions of applied steps.
+func (cfg *Repository) Migrate(ctx context.Context) ([]int, error) {
+	if cfg == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	applied, err := model.Migration(ctx, cfg.Db())
+	if err != nil {
+		return applied, errs.Wrap(err)
+	}
+	return applied, nil
+}
+
 // Logger method returns zap.Logger instance.
 func (cfg *Repository) Logger() *zap.Logger {
 	if cfg == nil || cfg.logger == nil {
@@ -0,0 +1,189 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/goark/errs"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// batchSize is number of records in one INSERT statement (and one IN clause).
+const batchSize = 200
+
+// SaveResult is result of saving records.
+type SaveResult struct {
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Skipped  int `json:"skipped"`
+}
+
+// Add method adds counts in other result.
+func (r *SaveResult) Add(other *SaveResult) {
+	if r == nil || other == nil {
+		return
+	}
+	r.Inserted += other.Inserted
+	r.Updated += other.Updated
+	r.Skipped += other.Skipped
+}
+
+// String method is Stringer for SaveResult.
+func (r *SaveResult) String() string {
+	if r == nil {
+		return ""
+	}
+	return fmt.Sprintf("inserted %d, updated %d, skipped %d", r.Inserted, r.Updated, r.Skipped)
+}
+
+// upsertRecords function saves records in batches in one transaction.
+// Records conflicting on unique key column are updated (if update is true) or skipped.
+// Records with empty key are skipped, and duplicated records in list are merged (last one is saved).
+// ID and deleted time of records are cleared, because they are given by this database (records may come from other database).
+func upsertRecords[T any](ctx context.Context, db *gorm.DB, list []T, keyColumn string, keyOf func(*T) string, update bool) (*SaveResult, error) {
+	res := &SaveResult{}
+	// unique records
+	records := make([]T, 0, len(list))
+	index := map[string]int{}
+	for _, r := range list {
+		key := keyOf(&r)
+		if len(key) == 0 {
+			res.Skipped++
+			continue
+		}
+		if i, ok := index[key]; ok {
+			records[i] = r
+			res.Skipped++
+			continue
+		}
+		index[key] = len(records)
+		records = append(records, r)
+	}
+	if len(records) == 0 {
+		return res, nil
+	}
+	if err := resetIdentity(ctx, db, records); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	if err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// existing keys (including soft-deleted records)
+		exist, err := existingKeys[T](tx, records, keyColumn, keyOf)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		onConflict := clause.OnConflict{Columns: []clause.Column{{Name: keyColumn}}, DoNothing: true}
+		if update {
+			columns, err := updateColumns[T](tx, keyColumn)
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			onConflict = clause.OnConflict{Columns: []clause.Column{{Name: keyColumn}}, DoUpdates: clause.AssignmentColumns(columns)}
+		} else {
+			news := make([]T, 0, len(records))
+			for _, r := range records {
+				if !exist[keyOf(&r)] {
+					news = append(news, r)
+				}
+			}
+			res.Skipped += len(records) - len(news)
+			records = news
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		if t := tx.Clauses(onConflict).CreateInBatches(records, batchSize); t.Error != nil {
+			return errs.Wrap(t.Error)
+		}
+		for _, r := range records {
+			if exist[keyOf(&r)] {
+				res.Updated++
+			} else {
+				res.Inserted++
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return res, nil
+}
+
+// resetIdentity function clears primary key and deleted time (soft delete) of records.
+func resetIdentity[T any](ctx context.Context, db *gorm.DB, records []T) error {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return errs.Wrap(err)
+	}
+	for i := range records {
+		rv := reflect.ValueOf(&records[i]).Elem()
+		for _, field := range stmt.Schema.Fields {
+			if field.PrimaryKey || field.DBName == "deleted_at" {
+				field.ReflectValueOf(ctx, rv).SetZero()
+			}
+		}
+	}
+	return nil
+}
+
+// existingKeys function returns set of keys which exist in table.
+func existingKeys[T any](tx *gorm.DB, records []T, keyColumn string, keyOf func(*T) string) (map[string]bool, error) {
+	exist := map[string]bool{}
+	for start := 0; start < len(records); start += batchSize {
+		end := min(start+batchSize, len(records))
+		keys := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			keys = append(keys, keyOf(&records[i]))
+		}
+		var found []string
+		if t := tx.Unscoped().Model(new(T)).Where(clause.IN{Column: clause.Column{Name: keyColumn}, Values: toValues(keys)}).Pluck(keyColumn, &found); t.Error != nil {
+			return nil, errs.Wrap(t.Error)
+		}
+		for _, k := range found {
+			exist[k] = true
+		}
+	}
+	return exist, nil
+}
+
+// updateColumns function returns columns updated on conflict (all columns except primary key, key column and created_at).
+// Soft-deleted record is restored by updating deleted_at column.
+func updateColumns[T any](tx *gorm.DB, keyColumn string) ([]string, error) {
+	stmt := &gorm.Statement{DB: tx}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	columns := []string{}
+	for _, field := range stmt.Schema.Fields {
+		if len(field.DBName) == 0 || field.PrimaryKey || field.DBName == keyColumn || field.DBName == "created_at" {
+			continue
+		}
+		columns = append(columns, field.DBName)
+	}
+	return columns, nil
+}
+
+func toValues(keys []string) []any {
+	values := make([]any, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, k)
+	}
+	return values
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
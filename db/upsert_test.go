@@ -0,0 +1,111 @@
+package db_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/db/model"
+	"gorm.io/gorm"
+)
+
+func TestUpsert(t *testing.T) {
+	ctx := context.Background()
+	repos := openRepository(t)
+
+	pages := make([]model.Webpage, 0, 500)
+	for i := 0; i < 500; i++ {
+		pages = append(pages, model.Webpage{URL: fmt.Sprintf("https://example.com/%d", i), Title: "first"})
+	}
+	testCases := []struct {
+		name   string
+		save   func([]model.Webpage) (*db.SaveResult, error)
+		list   []model.Webpage
+		result db.SaveResult
+	}{
+		{name: "insert", save: func(l []model.Webpage) (*db.SaveResult, error) { return repos.InsertWebpage(ctx, l) }, list: pages, result: db.SaveResult{Inserted: 500}},
+		{name: "insert again", save: func(l []model.Webpage) (*db.SaveResult, error) { return repos.InsertWebpage(ctx, l) }, list: pages[:10], result: db.SaveResult{Skipped: 10}},
+		{
+			name:   "insert with duplicate and empty URL",
+			save:   func(l []model.Webpage) (*db.SaveResult, error) { return repos.InsertWebpage(ctx, l) },
+			list:   []model.Webpage{{URL: "https://example.com/new"}, {URL: "https://example.com/new"}, {URL: ""}, pages[0]},
+			result: db.SaveResult{Inserted: 1, Skipped: 3},
+		},
+		{
+			name:   "upsert",
+			save:   func(l []model.Webpage) (*db.SaveResult, error) { return repos.UpsertWebpages(ctx, l) },
+			list:   []model.Webpage{{URL: "https://example.com/0", Title: "second"}, {URL: "https://example.com/upsert", Title: "second"}},
+			result: db.SaveResult{Inserted: 1, Updated: 1},
+		},
+	}
+	for _, tc := range testCases {
+		res, err := tc.save(tc.list)
+		if err != nil {
+			t.Errorf("%s: error = \"%+v\", want nil.", tc.name, err)
+			continue
+		}
+		if *res != tc.result {
+			t.Errorf("%s: result = %v, want %v.", tc.name, res, &tc.result)
+		}
+	}
+
+	data, err := repos.FindWebpageByURL(ctx, "https://example.com/0")
+	if err != nil {
+		t.Fatalf("FindWebpageByURL() error = \"%+v\", want nil.", err)
+	}
+	if data == nil || data.Title != "second" {
+		t.Errorf("FindWebpageByURL() = %+v, want title %v.", data, "second")
+	}
+}
+
+func TestUpsertResetIdentity(t *testing.T) {
+	ctx := context.Background()
+	repos := openRepository(t)
+
+	if _, err := repos.UpsertWebpages(ctx, []model.Webpage{{URL: "https://example.com/identity/1", Title: "first"}}); err != nil {
+		t.Fatalf("UpsertWebpages() error = \"%+v\", want nil.", err)
+	}
+	first, err := repos.FindWebpageByURL(ctx, "https://example.com/identity/1")
+	if err != nil || first == nil {
+		t.Fatalf("FindWebpageByURL() = %+v, error = \"%+v\", want record.", first, err)
+	}
+
+	// record from other database: ID of other record and deleted time
+	other := model.Webpage{URL: "https://example.com/identity/2", Title: "second"}
+	other.ID = first.ID
+	other.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	res, err := repos.UpsertWebpages(ctx, []model.Webpage{other})
+	if err != nil {
+		t.Fatalf("UpsertWebpages() error = \"%+v\", want nil.", err)
+	}
+	if *res != (db.SaveResult{Inserted: 1}) {
+		t.Errorf("UpsertWebpages() result = %v, want %v.", res, &db.SaveResult{Inserted: 1})
+	}
+	if other.ID != first.ID || !other.DeletedAt.Valid {
+		t.Errorf("UpsertWebpages() must not change records in argument: %+v.", other)
+	}
+	second, err := repos.FindWebpageByURL(ctx, "https://example.com/identity/2")
+	if err != nil || second == nil || second.ID == first.ID {
+		t.Errorf("FindWebpageByURL() = %+v, error = \"%+v\", want new record (not deleted).", second, err)
+	}
+	if data, err := repos.FindWebpageByURL(ctx, "https://example.com/identity/1"); err != nil || data == nil || data.Title != "first" {
+		t.Errorf("FindWebpageByURL() = %+v, error = \"%+v\", want title %v.", data, err, "first")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -30,23 +30,34 @@ func (repos *Repository) FindWebpageByURL(ctx context.Context, url string) (*mod
 	return &data, nil
 }
 
-// InsertAPODData method inserts APOD data to database.
-func (repos *Repository) InsertWebpage(ctx context.Context, datalist []model.Webpage) error {
+// InsertWebpage method inserts Webpage data to database in one transaction. Data with existing URL is skipped.
+func (repos *Repository) InsertWebpage(ctx context.Context, datalist []model.Webpage) (*SaveResult, error) {
 	if repos == nil {
-		return errs.Wrap(ecode.ErrNullPointer)
+		return nil, errs.Wrap(ecode.ErrNullPointer)
 	}
-	for _, data := range datalist {
-		data := data
-		if err := repos.Db().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			if t := tx.Create(&data); t.Error != nil {
-				return errs.Wrap(t.Error)
-			}
-			return nil
-		}); err != nil {
-			return err
-		}
+	res, err := upsertRecords(ctx, repos.Db(), datalist, "url", webpageKey, false)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	repos.Logger().Debug("insert webpage data", zap.Stringer("result", res))
+	return res, nil
+}
+
+// UpsertWebpages method inserts or updates (by URL) Webpage data in database in one transaction.
+func (repos *Repository) UpsertWebpages(ctx context.Context, datalist []model.Webpage) (*SaveResult, error) {
+	if repos == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	res, err := upsertRecords(ctx, repos.Db(), datalist, "url", webpageKey, true)
+	if err != nil {
+		return nil, errs.Wrap(err)
 	}
-	return nil
+	repos.Logger().Debug("upsert webpage data", zap.Stringer("result", res))
+	return res, nil
+}
+
+func webpageKey(data *model.Webpage) string {
+	return data.URL
 }
 
 /* Copyright 2023 Spiegel
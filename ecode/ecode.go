@@ -14,6 +14,15 @@ var (
 	ErrNoAPODImage             = errors.New("no APOD image")
 	ErrExistAPODData           = errors.New("exist APOD data")
 	ErrNoFeed                  = errors.New("no feed")
+	ErrInvalidFormat           = errors.New("invalid output format")
+	ErrInvalidSchedule         = errors.New("invalid schedule")
+	ErrNoFeedList              = errors.New("no feed list file")
+	ErrNoDestination           = errors.New("no such destination")
+	ErrNoLink                  = errors.New("no link in feed item")
+	ErrInvalidTable            = errors.New("invalid table name")
+	ErrNoPruneCondition        = errors.New("no condition for pruning")
+	ErrInvalidInterval         = errors.New("invalid interval")
+	ErrTooLongSpoilerText      = errors.New("too long spoiler text")
 )
 
 /* Copyright 2023 Spiegel
@@ -17,7 +17,7 @@ func newAPODLookupCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Lookup Astronomy Picture of the Day data.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
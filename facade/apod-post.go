@@ -8,9 +8,8 @@ import (
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
 	"github.com/goark/toolbox/apod"
-	"github.com/goark/toolbox/bluesky"
 	"github.com/goark/toolbox/ecode"
-	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
 	"github.com/goark/toolbox/values"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
@@ -25,7 +24,7 @@ func newAPODPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post Astronomy Picture of the Day data to time lines.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -46,14 +45,6 @@ func newAPODPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			bskyFlag, err := cmd.Flags().GetBool("bluesky")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
-			mastodonFlag, err := cmd.Flags().GetBool("mastodon")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
 			forceFlag, err := cmd.Flags().GetBool("force")
 			if err != nil {
 				return debugPrint(ui, err)
@@ -71,48 +62,22 @@ func newAPODPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil && !errs.Is(err, ecode.ErrNoAPODImage) {
 				return debugPrint(ui, err)
 			}
-			var imgs []string
+			var imgs []*poster.Image
 			if len(fname) > 0 {
 				defer os.Remove(fname)
-				imgs = []string{fname}
+				imgs = []*poster.Image{{Path: fname, Alt: apod.AltText(res)}}
 			}
 
 			// make message
 			msg := apod.MakeMessage(res)
 
-			var lastErrs []error
-
-			// post to Bluesky
-			if bskyFlag {
-				wp, err := gopts.getWebpage(cmd.Context())
-				if err != nil {
-					return debugPrint(ui, err)
-				}
-				if bsky, err := gopts.getBluesky(wp); err != nil {
-					apd.Logger().Info("no Bluesky configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: imgs}); err != nil {
-					bsky.Logger().Error("error in bluesky.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Bluesky:", resText)
-				}
-			}
-			// post to Mastodon
-			if mastodonFlag {
-				if mstdn, err := gopts.getMastodon(); err != nil {
-					apd.Logger().Info("no Mastodon configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := mstdn.PostMessage(cmd.Context(), &mastodon.Message{
-					Msg:        msg,
-					ImageFiles: imgs,
-				}); err != nil {
-					mstdn.Logger().Error("error in mastodon.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Mastodon:", resText)
-				}
-			}
+			// post message
+			dispatcher, lastErrs := gopts.getDispatcher(cmd, nil)
+			lastErrs = append(lastErrs, gopts.dispatchMessage(cmd, ui, dispatcher, &poster.Message{
+				Text:   msg,
+				Images: imgs,
+				Source: poster.Source{Type: poster.SourceAPOD, ID: res.Date.String()},
+			})...)
 
 			if len(lastErrs) > 0 {
 				return debugPrint(ui, errs.Wrap(errors.Join(lastErrs...)))
@@ -120,9 +85,8 @@ func newAPODPostCmd(ui *rwi.RWI) *cobra.Command {
 			return nil
 		},
 	}
-	apodPostCmd.Flags().BoolP("bluesky", "b", false, "Post to bluesky")
-	apodPostCmd.Flags().BoolP("mastodon", "m", false, "Post to Mastodon")
 	apodPostCmd.Flags().BoolP("force", "", false, "Force getting APOD data from cache")
+	addPosterFlags(apodPostCmd)
 
 	return apodPostCmd
 }
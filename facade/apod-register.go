@@ -22,7 +22,7 @@ func newAPODRegisterCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Register NASA API key.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
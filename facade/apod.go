@@ -35,7 +35,11 @@ func newAPODCmd(ui *rwi.RWI) *cobra.Command {
 }
 
 func (gopts *globalOptions) getAPOD(ctx context.Context) (*apod.APOD, error) {
-	acfg, err := apod.New(ctx, gopts.apodConfigPath, gopts.CacheDir, gopts.Logger)
+	repos, err := gopts.getRepository(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	acfg, err := apod.NewWithRepository(gopts.apodConfigPath, gopts.CacheDir, repos, gopts.Logger)
 	if err != nil {
 		err = errs.Wrap(err)
 		gopts.Logger.Desugar().Error("cannot get configuration for Mastodon", zap.Object("error", zapobject.New(err)))
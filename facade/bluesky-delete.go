@@ -0,0 +1,58 @@
+package facade
+
+import (
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newBlueskyDeleteCmd returns cobra.Command instance for show sub-command
+func newBlueskyDeleteCmd(ui *rwi.RWI) *cobra.Command {
+	blueskyDeleteCmd := &cobra.Command{
+		Use:     "delete <uri>",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete post in Bluesky",
+		Long:    "Delete post in Bluesky by record URI (at://...) or post URL.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			wp, err := gopts.getWebpage(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			bsky, err := gopts.getBluesky(wp)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// delete post
+			if err := bsky.DeletePost(cmd.Context(), args[0]); err != nil {
+				bsky.Logger().Error("error in bluesky.DeletePost", zap.Object("error", zapobject.New(err)))
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln("deleted:", args[0]))
+		},
+	}
+
+	return blueskyDeleteCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
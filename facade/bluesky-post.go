@@ -6,6 +6,7 @@ import (
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
 	"github.com/goark/toolbox/bluesky"
+	"github.com/goark/toolbox/poster"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -19,7 +20,7 @@ func newBlueskyPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post message to Bluesky.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -32,19 +33,33 @@ func newBlueskyPostCmd(ui *rwi.RWI) *cobra.Command {
 				return debugPrint(ui, err)
 			}
 			// local options
-			images, err := cmd.Flags().GetStringSlice("image-file")
+			imageFiles, err := cmd.Flags().GetStringArray("image-file")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
+			alts, err := cmd.Flags().GetStringArray("alt")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			images := poster.ParseImages(imageFiles, alts)
 			replyTo, err := cmd.Flags().GetString("reply-to")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
 			replyTo = strings.TrimSpace(replyTo)
+			quote, err := cmd.Flags().GetString("quote")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			quote = strings.TrimSpace(quote)
 			msg, err := cmd.Flags().GetString("text")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
+			threadFlag, err := cmd.Flags().GetBool("thread")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
 			pipeFlag, err := cmd.Flags().GetBool("pipe")
 			if err != nil {
 				return debugPrint(ui, err)
@@ -67,7 +82,7 @@ func newBlueskyPostCmd(ui *rwi.RWI) *cobra.Command {
 			msg = strings.TrimSpace(msg)
 
 			// post message
-			resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: images, ReplryTo: replyTo})
+			resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: images.Files(), ImageAlts: images.Alts(), ReplryTo: replyTo, Quote: quote, Thread: threadFlag})
 			if err != nil {
 				bsky.Logger().Error("error in bluesky.PostMessage", zap.Object("error", zapobject.New(err)))
 				return debugPrint(ui, err)
@@ -79,8 +94,11 @@ func newBlueskyPostCmd(ui *rwi.RWI) *cobra.Command {
 	blueskyPostCmd.Flags().BoolP("pipe", "", false, "Input from standard-input")
 	blueskyPostCmd.Flags().BoolP("edit", "", false, "Edit message")
 	blueskyPostCmd.MarkFlagsMutuallyExclusive("text", "pipe", "edit")
-	blueskyPostCmd.Flags().StringSliceP("image-file", "i", nil, "Image file")
+	blueskyPostCmd.Flags().StringArrayP("image-file", "i", nil, "Image file (path or path"+poster.AltSeparator+"alt-text)")
+	blueskyPostCmd.Flags().StringArrayP("alt", "", nil, "Alt text for image file (same order as --image-file)")
 	blueskyPostCmd.Flags().StringP("reply-to", "r", "", "Replry URI")
+	blueskyPostCmd.Flags().StringP("quote", "q", "", "Quote URI (at://... or https://bsky.app/profile/.../post/...)")
+	blueskyPostCmd.Flags().BoolP("thread", "", false, "Post long message as thread")
 	return blueskyPostCmd
 }
 
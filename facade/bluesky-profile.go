@@ -19,7 +19,7 @@ func newBlueskyProfileCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Output Bluesky profile.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -24,7 +24,7 @@ func newBlueskyRegisterCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Register Bluesky account in local PC.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -26,6 +26,7 @@ func newBlueskyCmd(ui *rwi.RWI) *cobra.Command {
 		newBlueskyRegisterCmd(ui),
 		newBlueskyPostCmd(ui),
 		newBlueskyProfileCmd(ui),
+		newBlueskyDeleteCmd(ui),
 	)
 	return blueskyCmd
 }
@@ -14,7 +14,7 @@ func newCalendarLookupCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Lookup astronomical calendar.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -7,8 +7,7 @@ import (
 	"github.com/goark/errs"
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
-	"github.com/goark/toolbox/bluesky"
-	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -22,7 +21,7 @@ func newCalendarPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post astronomical calendar data to time lines.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -36,14 +35,6 @@ func newCalendarPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			bskyFlag, err := cmd.Flags().GetBool("bluesky")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
-			mastodonFlag, err := cmd.Flags().GetBool("mastodon")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
 
 			// lookup calendar data
 			b := &bytes.Buffer{}
@@ -53,39 +44,12 @@ func newCalendarPostCmd(ui *rwi.RWI) *cobra.Command {
 			}
 			msg := b.String()
 
-			var lastErrs []error
-
-			// post to Bluesky
-			if bskyFlag {
-				wp, err := gopts.getWebpage(cmd.Context())
-				if err != nil {
-					return debugPrint(ui, err)
-				}
-				if bsky, err := gopts.getBluesky(wp); err != nil {
-					gopts.Logger.Info("no Bluesky configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: nil}); err != nil {
-					bsky.Logger().Error("error in bluesky.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Bluesky:", resText)
-				}
-			}
-			// post to Mastodon
-			if mastodonFlag {
-				if mstdn, err := gopts.getMastodon(); err != nil {
-					gopts.Logger.Info("no Mastodon configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := mstdn.PostMessage(cmd.Context(), &mastodon.Message{
-					Msg:        msg,
-					ImageFiles: nil,
-				}); err != nil {
-					mstdn.Logger().Error("error in mastodon.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Mastodon:", resText)
-				}
-			}
+			// post message
+			dispatcher, lastErrs := gopts.getDispatcher(cmd, nil)
+			lastErrs = append(lastErrs, gopts.dispatchMessage(cmd, ui, dispatcher, &poster.Message{
+				Text:   msg,
+				Source: poster.Source{Type: poster.SourceCalendar, ID: ccfg.Range()},
+			})...)
 
 			if len(lastErrs) > 0 {
 				return debugPrint(ui, errs.Wrap(errors.Join(lastErrs...)))
@@ -93,8 +57,7 @@ func newCalendarPostCmd(ui *rwi.RWI) *cobra.Command {
 			return nil
 		},
 	}
-	calendarPostCmd.Flags().BoolP("bluesky", "b", false, "Post to bluesky")
-	calendarPostCmd.Flags().BoolP("mastodon", "m", false, "Post to Mastodon")
+	addPosterFlags(calendarPostCmd)
 
 	return calendarPostCmd
 }
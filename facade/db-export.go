@@ -0,0 +1,76 @@
+package facade
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db"
+	"github.com/spf13/cobra"
+)
+
+// newDBExportCmd returns cobra.Command instance for show sub-command
+func newDBExportCmd(ui *rwi.RWI) *cobra.Command {
+	dbExportCmd := &cobra.Command{
+		Use:     "export",
+		Aliases: []string{"exp"},
+		Short:   "Export tables as JSON Lines",
+		Long:    "Export webpage, APOD and history tables as JSON Lines (for backup or migration between machines).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			tables, err := cmd.Flags().GetStringSlice("table")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			path, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			repos, err := gopts.getRepository(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(path) == 0 {
+				_, err := repos.Export(cmd.Context(), ui.Writer(), tables...)
+				return debugPrint(ui, err)
+			}
+			file, err := os.Create(path)
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err, errs.WithContext("path", path)))
+			}
+			defer file.Close()
+			count, err := repos.Export(cmd.Context(), file, tables...)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln(fmt.Sprintf("exported %d records to %s", count, path)))
+		},
+	}
+	dbExportCmd.Flags().StringSliceP("table", "t", nil, fmt.Sprintf("Tables for export [%s] (default: all tables)", strings.Join(db.BackupTables(), "|")))
+	dbExportCmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+
+	return dbExportCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,75 @@
+package facade
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db"
+	"github.com/spf13/cobra"
+)
+
+// newDBImportCmd returns cobra.Command instance for show sub-command
+func newDBImportCmd(ui *rwi.RWI) *cobra.Command {
+	dbImportCmd := &cobra.Command{
+		Use:     "import [<JSON Lines file>]",
+		Aliases: []string{"imp"},
+		Short:   "Import tables from JSON Lines",
+		Long:    "Import webpage, APOD and history tables from JSON Lines exported by db export command (default: stdin). Webpages and APOD data are updated by URL and date, and histories already saved are skipped.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			tables, err := cmd.Flags().GetStringSlice("table")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			repos, err := gopts.getRepository(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			r := ui.Reader()
+			if len(args) > 0 {
+				file, err := os.Open(args[0])
+				if err != nil {
+					return debugPrint(ui, errs.Wrap(err, errs.WithContext("path", args[0])))
+				}
+				defer file.Close()
+				r = file
+			}
+			results, err := repos.Import(cmd.Context(), r, tables...)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			for _, name := range db.BackupTables() {
+				_ = ui.Outputln(fmt.Sprintf("%-8s %s", name, results[name]))
+			}
+			return nil
+		},
+	}
+	dbImportCmd.Flags().StringSliceP("table", "t", nil, fmt.Sprintf("Tables for import [%s] (default: all tables)", strings.Join(db.BackupTables(), "|")))
+
+	return dbImportCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,78 @@
+package facade
+
+import (
+	"fmt"
+
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db/model"
+	"github.com/spf13/cobra"
+)
+
+// newDBMigrateCmd returns cobra.Command instance for show sub-command
+func newDBMigrateCmd(ui *rwi.RWI) *cobra.Command {
+	dbMigrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply schema migrations to database",
+		Long:  "Apply pending schema migrations to database (migrations are also applied at every opening of database by other commands).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			repos, err := gopts.getRepositoryWithoutMigration(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			statusFlag, err := cmd.Flags().GetBool("status")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			if statusFlag {
+				list, err := repos.MigrationStatus(cmd.Context())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				for _, st := range list {
+					applied := "pending"
+					if st.Applied && st.AppliedAt != nil {
+						applied = st.AppliedAt.Local().Format("2006-01-02 15:04:05")
+					}
+					if err := ui.Outputln(fmt.Sprintf("%3d  %-19s  %s", st.Version, applied, st.Name)); err != nil {
+						return debugPrint(ui, err)
+					}
+				}
+				return nil
+			}
+
+			applied, err := repos.Migrate(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(applied) == 0 {
+				return debugPrint(ui, ui.Outputln(fmt.Sprintf("schema is up to date (version %d)", model.LatestVersion())))
+			}
+			return debugPrint(ui, ui.Outputln(fmt.Sprintf("applied migrations: %v", applied)))
+		},
+	}
+	dbMigrateCmd.Flags().BoolP("status", "", false, "Output status of migrations")
+
+	return dbMigrateCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,77 @@
+package facade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/ecode"
+	"github.com/spf13/cobra"
+)
+
+// newDBPruneCmd returns cobra.Command instance for show sub-command
+func newDBPruneCmd(ui *rwi.RWI) *cobra.Command {
+	dbPruneCmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete old webpages and soft-deleted rows",
+		Long:  "Delete permanently webpages fetched more than N days ago (--days) and/or soft-deleted rows in all tables (--deleted).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			days, err := cmd.Flags().GetInt("days")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			deletedFlag, err := cmd.Flags().GetBool("deleted")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			cond := &db.PruneCondition{Deleted: deletedFlag}
+			if days > 0 {
+				before := time.Now().AddDate(0, 0, -days)
+				cond.Before = &before
+			}
+			if cond.Before == nil && !cond.Deleted {
+				return debugPrint(ui, errs.Wrap(ecode.ErrNoPruneCondition))
+			}
+
+			repos, err := gopts.getRepository(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			results, err := repos.Prune(cmd.Context(), cond)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			for _, res := range results {
+				_ = ui.Outputln(fmt.Sprintf("%-16s %-12s %10d rows deleted", res.Table, res.Kind, res.Rows))
+			}
+			return nil
+		},
+	}
+	dbPruneCmd.Flags().IntP("days", "", 0, "Delete webpages fetched more than N days ago")
+	dbPruneCmd.Flags().BoolP("deleted", "", false, "Delete soft-deleted rows in all tables")
+
+	return dbPruneCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
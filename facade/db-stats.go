@@ -0,0 +1,67 @@
+package facade
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+)
+
+// newDBStatsCmd returns cobra.Command instance for show sub-command
+func newDBStatsCmd(ui *rwi.RWI) *cobra.Command {
+	dbStatsCmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Output statistics of database",
+		Long:  "Output statistics of database: file size and number of rows in each table.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			repos, err := gopts.getRepository(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			jsonFlag, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			stats, err := repos.Stats(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if jsonFlag {
+				return debugPrint(ui, json.NewEncoder(ui.Writer()).Encode(stats))
+			}
+			_ = ui.Outputln(fmt.Sprintf("file: %s (%d bytes)", stats.Path, stats.FileSize))
+			_ = ui.Outputln(fmt.Sprintf("schema version: %d", stats.Version))
+			_ = ui.Outputln(fmt.Sprintf("%-16s %10s %10s", "table", "rows", "deleted"))
+			for _, ts := range stats.Tables {
+				_ = ui.Outputln(fmt.Sprintf("%-16s %10d %10d", ts.Table, ts.Rows, ts.Deleted))
+			}
+			return nil
+		},
+	}
+	dbStatsCmd.Flags().BoolP("json", "j", false, "Output JSON format")
+
+	return dbStatsCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
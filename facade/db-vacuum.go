@@ -0,0 +1,50 @@
+package facade
+
+import (
+	"fmt"
+
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+)
+
+// newDBVacuumCmd returns cobra.Command instance for show sub-command
+func newDBVacuumCmd(ui *rwi.RWI) *cobra.Command {
+	dbVacuumCmd := &cobra.Command{
+		Use:   "vacuum",
+		Short: "Compact database file",
+		Long:  "Compact database file (VACUUM).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			repos, err := gopts.getRepository(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			before, after, err := repos.Vacuum(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln(fmt.Sprintf("compacted %s: %d -> %d bytes", repos.Path(), before, after)))
+		},
+	}
+	return dbVacuumCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,75 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/ecode"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newDBCmd returns cobra.Command instance for show sub-command
+func newDBCmd(ui *rwi.RWI) *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Maintenance of database",
+		Long:  "Maintenance of database in cache directory.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugPrint(ui, errs.Wrap(ecode.ErrNoCommand))
+		},
+	}
+	dbCmd.AddCommand(
+		newDBStatsCmd(ui),
+		newDBVacuumCmd(ui),
+		newDBPruneCmd(ui),
+		newDBExportCmd(ui),
+		newDBImportCmd(ui),
+		newDBMigrateCmd(ui),
+	)
+	return dbCmd
+}
+
+// getRepository method opens database (with schema migrations) at first call, and returns the same instance after that.
+func (gopts *globalOptions) getRepository(ctx context.Context) (*db.Repository, error) {
+	if gopts.repos != nil {
+		return gopts.repos, nil
+	}
+	repos, err := db.Open(ctx, gopts.CacheDir, gopts.Logger)
+	if err != nil {
+		err = errs.Wrap(err)
+		gopts.Logger.Desugar().Error("cannot open database", zap.Object("error", zapobject.New(err)))
+		return nil, err
+	}
+	gopts.repos = repos
+	return repos, nil
+}
+
+// getRepositoryWithoutMigration method opens database without applying schema migrations.
+func (gopts *globalOptions) getRepositoryWithoutMigration(ctx context.Context) (*db.Repository, error) {
+	repos, err := db.OpenWithoutMigration(ctx, gopts.CacheDir, gopts.Logger)
+	if err != nil {
+		err = errs.Wrap(err)
+		gopts.Logger.Desugar().Error("cannot open database", zap.Object("error", zapobject.New(err)))
+		return nil, err
+	}
+	return repos, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
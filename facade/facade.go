@@ -7,6 +7,8 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	"sync"
+	"syscall"
 
 	"github.com/goark/errs"
 	"github.com/goark/gocli/cache"
@@ -36,8 +38,9 @@ const (
 )
 
 var (
-	debugFlag              bool   //debug flag
-	configPath             string //path for config file
+	debugFlag              bool      //debug flag
+	initOnce               sync.Once //for registering initConfig function
+	configPath             string    //path for config file
 	defaultConfigPath      = config.Path(Name, configFile+".yaml")
 	defaultBskyConfigPath  = config.Path(Name, bskyConfigFile)
 	defaultMstdnConfigPath = config.Path(Name, mstdnConfigFile)
@@ -71,7 +74,7 @@ func newRootCmd(ui *rwi.RWI, args []string) *cobra.Command {
 	_ = viper.BindPFlag("bluesky-config", rootCmd.PersistentFlags().Lookup("bluesky-config"))
 	_ = viper.BindPFlag("mastodon-config", rootCmd.PersistentFlags().Lookup("mastodon-config"))
 	_ = viper.BindPFlag("apod-config", rootCmd.PersistentFlags().Lookup("apod-config"))
-	cobra.OnInitialize(initConfig)
+	initOnce.Do(func() { cobra.OnInitialize(initConfig) })
 
 	// global options (other)
 	rootCmd.PersistentFlags().BoolVarP(&debugFlag, "debug", "", false, "for debug")
@@ -83,7 +86,13 @@ func newRootCmd(ui *rwi.RWI, args []string) *cobra.Command {
 	rootCmd.SetIn(ui.Reader())       //Stdin
 	rootCmd.SetOut(ui.ErrorWriter()) //Stdout -> Stderr
 	rootCmd.SetErr(ui.ErrorWriter()) //Stderr
-	rootCmd.AddCommand(
+	rootCmd.AddCommand(subCommands(ui)...)
+	return rootCmd
+}
+
+// subCommands function returns list of sub-commands of root command.
+func subCommands(ui *rwi.RWI) []*cobra.Command {
+	return []*cobra.Command{
 		newVersionCmd(ui),
 		newBlueskyCmd(ui),
 		newMastodonCmd(ui),
@@ -91,8 +100,11 @@ func newRootCmd(ui *rwi.RWI, args []string) *cobra.Command {
 		newWebpageCmd(ui),
 		newFeedCmd(ui),
 		newCalendarCmd(ui),
-	)
-	return rootCmd
+		newHistoryCmd(ui),
+		newQueueCmd(ui),
+		newDBCmd(ui),
+		newServeCmd(ui),
+	}
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -133,7 +145,7 @@ func Execute(ui *rwi.RWI, args []string) (exit exitcode.ExitCode) {
 	}()
 
 	// create interrupt SIGNAL
-	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
 	//execution
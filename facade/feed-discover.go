@@ -0,0 +1,100 @@
+package facade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/webpage"
+	"github.com/spf13/cobra"
+)
+
+// newFeedDiscoverCmd returns cobra.Command instance for show sub-command
+func newFeedDiscoverCmd(ui *rwi.RWI) *cobra.Command {
+	feedDiscoverCmd := &cobra.Command{
+		Use:     "discover <web page URL>",
+		Aliases: []string{"disc", "d"},
+		Short:   "Discover feeds in Web site",
+		Long:    "Discover feeds in Web site. Feeds are looked for in link elements of the Web page, or probed in common paths.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// local options
+			addFlag, err := cmd.Flags().GetBool("add")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			index, err := cmd.Flags().GetInt("select")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			feedListPath, err := cmd.Flags().GetString("feed-list-file")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			group, err := cmd.Flags().GetString("group")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if addFlag && len(feedListPath) == 0 {
+				return debugPrint(ui, errs.Wrap(ecode.ErrNoFeedList))
+			}
+
+			// discover feeds
+			candidates, err := webpage.DiscoverFeeds(cmd.Context(), args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if !addFlag {
+				return debugPrint(ui, json.NewEncoder(ui.Writer()).Encode(candidates))
+			}
+			if len(candidates) == 0 {
+				return debugPrint(ui, errs.Wrap(ecode.ErrNoFeed, errs.WithContext("url", args[0])))
+			}
+			if index < 1 || index > len(candidates) {
+				return debugPrint(ui, errs.New("invalid candidate number", errs.WithContext("select", index), errs.WithContext("candidates", len(candidates))))
+			}
+
+			// add feed to feed list file
+			c := candidates[index-1]
+			entry := &webpage.FeedEntry{URL: c.URL, Title: c.Title}
+			if len(group) > 0 {
+				entry.Tags = []string{group}
+			}
+			fl, err := webpage.NewFeedList(feedListPath)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return debugPrint(ui, err)
+			}
+			fl, count := fl.Merge(webpage.FeedList{entry})
+			if err := fl.Save(feedListPath); err != nil {
+				return debugPrint(ui, err)
+			}
+			if count == 0 {
+				return debugPrint(ui, ui.Outputln(fmt.Sprintf("%s already exists in %s", c.URL, feedListPath)))
+			}
+			return debugPrint(ui, ui.Outputln(fmt.Sprintf("added %s to %s (total %d feeds)", c.URL, feedListPath, len(fl))))
+		},
+	}
+	feedDiscoverCmd.Flags().BoolP("add", "", false, "Add discovered feed to Feed list file")
+	feedDiscoverCmd.Flags().IntP("select", "", 1, "Number of candidate added to Feed list file (with --add)")
+
+	return feedDiscoverCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,78 @@
+package facade
+
+import (
+	"os"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/webpage"
+	"github.com/spf13/cobra"
+)
+
+// newFeedExportCmd returns cobra.Command instance for show sub-command
+func newFeedExportCmd(ui *rwi.RWI) *cobra.Command {
+	feedExportCmd := &cobra.Command{
+		Use:     "export",
+		Aliases: []string{"exp"},
+		Short:   "Export Feed list file as OPML",
+		Long:    "Export Feed list file as OPML. Tags are exported as outline groups.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// local options
+			feedListPath, err := cmd.Flags().GetString("feed-list-file")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(feedListPath) == 0 {
+				return debugPrint(ui, errs.Wrap(ecode.ErrNoFeedList))
+			}
+			group, err := cmd.Flags().GetString("group")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			title, err := cmd.Flags().GetString("title")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			path, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// export feeds
+			fl, err := webpage.NewFeedList(feedListPath)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			fl = fl.Filter(group)
+			if len(path) == 0 {
+				return debugPrint(ui, fl.EncodeOPML(ui.Writer(), title))
+			}
+			file, err := os.Create(path)
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err, errs.WithContext("path", path)))
+			}
+			defer file.Close()
+			return debugPrint(ui, fl.EncodeOPML(file, title))
+		},
+	}
+	feedExportCmd.Flags().StringP("title", "", "", "Title of OPML")
+	feedExportCmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+
+	return feedExportCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,69 @@
+package facade
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/webpage"
+	"github.com/spf13/cobra"
+)
+
+// newFeedImportCmd returns cobra.Command instance for show sub-command
+func newFeedImportCmd(ui *rwi.RWI) *cobra.Command {
+	feedImportCmd := &cobra.Command{
+		Use:     "import <OPML file>",
+		Aliases: []string{"imp"},
+		Short:   "Import feeds from OPML file",
+		Long:    "Import feeds from OPML file (or text feed list) to Feed list file. Outline groups are imported as tags.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// local options
+			feedListPath, err := cmd.Flags().GetString("feed-list-file")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(feedListPath) == 0 {
+				return debugPrint(ui, errs.Wrap(ecode.ErrNoFeedList))
+			}
+			group, err := cmd.Flags().GetString("group")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// merge feeds
+			src, err := webpage.NewFeedList(args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			fl, err := webpage.NewFeedList(feedListPath)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return debugPrint(ui, err)
+			}
+			fl, count := fl.Merge(src.Filter(group))
+			if err := fl.Save(feedListPath); err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln(fmt.Sprintf("imported %d feeds to %s (total %d feeds)", count, feedListPath, len(fl))))
+		},
+	}
+	return feedImportCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/webpage"
+	"github.com/goark/toolbox/webpage/feed"
 	"github.com/spf13/cobra"
 )
 
@@ -16,7 +18,7 @@ func newFeedLookupCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Lookup information for Web page.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -29,20 +31,33 @@ func newFeedLookupCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-
-			// lookup feed
-			list, err := getFeedAll(cmd, cfg)
+			showDropped, err := cmd.Flags().GetBool("show-dropped")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			if saveFlag && len(list) > 0 {
+
+			// lookup feed
+			list, err := getFeedAll(cmd, cfg)
+			if saveFlag {
+				// save states of feeds even if errors occur
 				if err := cfg.Save(cmd.Context(), list); err != nil {
 					return debugPrint(ui, err)
 				}
 			}
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if showDropped {
+				return debugPrint(ui, json.NewEncoder(ui.Writer()).Encode(struct {
+					Pages   []*webpage.Webpage  `json:"pages"`
+					Dropped []*feed.DroppedItem `json:"dropped"`
+				}{Pages: list, Dropped: cfg.DroppedItems()}))
+			}
 			return debugPrint(ui, json.NewEncoder(ui.Writer()).Encode(list))
 		},
 	}
+	feedLookupCmd.Flags().BoolP("show-dropped", "", false, "Output items dropped by filters with reasons")
+
 	return feedLookupCmd
 }
 
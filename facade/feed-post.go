@@ -6,10 +6,10 @@ import (
 	"strings"
 
 	"github.com/goark/errs"
-	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
-	"github.com/goark/toolbox/bluesky"
 	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
+	"github.com/goark/toolbox/webpage"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -23,7 +23,7 @@ func newFeedPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post Web page's information to time lines.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -36,43 +36,55 @@ func newFeedPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			bskyFlag, err := cmd.Flags().GetBool("bluesky")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
-			mastodonFlag, err := cmd.Flags().GetBool("mastodon")
+			withImage, err := cmd.Flags().GetBool("with-image")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			withImage, err := cmd.Flags().GetBool("with-image")
+			pmsg, err := cmd.Flags().GetString("prefix-text")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			pmsg, err := cmd.Flags().GetString("prefix-text")
+			feedDests, err := cmd.Flags().GetBool("feed-destinations")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
 
 			// lookup feed
 			list, err := getFeedAll(cmd, cfg)
-			if err != nil {
-				return debugPrint(ui, err)
-			}
-			if saveFlag && len(list) > 0 {
+			if saveFlag {
+				// save states of feeds even if errors occur
 				if err := cfg.Save(cmd.Context(), list); err != nil {
 					return debugPrint(ui, err)
 				}
 			}
+			if err != nil {
+				return debugPrint(ui, err)
+			}
 
-			// post feed data
+			// post feed data (with options of each feed in feed list)
 			var lastErrs []error
-			var bsky *bluesky.Bluesky
-			var mstdn *mastodon.Mastodon
+			flagNames, err := getDestinationNames(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			var others []string
+			if feedDests {
+				others = feedDestinations(list)
+			}
+			dispatcher, errList := gopts.getDispatcher(cmd, cfg, others...)
+			if len(errList) > 0 {
+				return debugPrint(ui, errs.Wrap(errors.Join(errList...)))
+			}
 			for _, page := range list {
 				gopts.Logger.Desugar().Debug("start posting web page info", zap.Any("info", page))
+				opts, err := getFeedPostOptions(page.FeedEntry(), flagNames, pmsg, withImage, feedDests)
+				if err != nil {
+					lastErrs = append(lastErrs, err)
+					continue
+				}
 				// get image file
-				var imgs []string
-				if withImage && len(page.ImageURL) > 0 {
+				var imgs []*poster.Image
+				if opts.withImage && len(page.ImageURL) > 0 {
 					fname, err := page.ImageFile(cmd.Context(), gopts.CacheDir)
 					if err != nil {
 						return debugPrint(ui, err)
@@ -80,43 +92,18 @@ func newFeedPostCmd(ui *rwi.RWI) *cobra.Command {
 					if len(fname) > 0 {
 						gopts.Logger.Desugar().Debug("downloaded image file", zap.String("url", page.ImageURL), zap.String("local", fname))
 						defer os.Remove(fname)
-						imgs = []string{fname}
+						imgs = []*poster.Image{{Path: fname, Alt: page.AltText()}}
 					}
 				}
 				// make message
-				msg := page.MakeMessage(strings.TrimSpace(pmsg))
-				// post to Bluesky
-				if bskyFlag {
-					if bsky == nil {
-						bsky, err = gopts.getBluesky(cfg)
-						if err != nil {
-							cfg.Logger().Info("no Bluesky configuration", zap.Object("error", zapobject.New(err)))
-							return debugPrint(ui, err)
-						}
-					}
-					if resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: imgs}); err != nil {
-						bsky.Logger().Error("error in bluesky.PostMessage", zap.Object("error", zapobject.New(err)))
-						lastErrs = append(lastErrs, err)
-					} else {
-						_ = ui.Outputln("post to Bluesky:", resText)
-					}
-				}
-				// post to Mastodon
-				if mastodonFlag {
-					if mstdn == nil {
-						mstdn, err = gopts.getMastodon()
-						if err != nil {
-							cfg.Logger().Info("no Mastodon configuration", zap.Object("error", zapobject.New(err)))
-							return debugPrint(ui, err)
-						}
-					}
-					if resText, err := mstdn.PostMessage(cmd.Context(), &mastodon.Message{Msg: msg, ImageFiles: imgs}); err != nil {
-						mstdn.Logger().Error("error in mastodon.PostMessage", zap.Object("error", zapobject.New(err)))
-						lastErrs = append(lastErrs, err)
-					} else {
-						_ = ui.Outputln("post to Mastodon:", resText)
-					}
-				}
+				msg := page.MakeMessage(strings.TrimSpace(opts.prefix))
+				// post message
+				lastErrs = append(lastErrs, gopts.dispatchMessage(cmd, ui, dispatcher.Select(opts.destinations...), &poster.Message{
+					Text:       msg,
+					Images:     imgs,
+					Visibility: opts.visibility,
+					Source:     poster.Source{Type: poster.SourceFeed, ID: page.URL},
+				})...)
 				gopts.Logger.Desugar().Debug("end posting web page info", zap.Any("info", page))
 			}
 
@@ -126,14 +113,62 @@ func newFeedPostCmd(ui *rwi.RWI) *cobra.Command {
 			return nil
 		},
 	}
-	bookmarkPostCmd.Flags().BoolP("bluesky", "b", false, "Post to bluesky")
-	bookmarkPostCmd.Flags().BoolP("mastodon", "m", false, "Post to Mastodon")
+	addPosterFlags(bookmarkPostCmd)
 	bookmarkPostCmd.Flags().BoolP("with-image", "", false, "Post with image")
 	bookmarkPostCmd.Flags().StringP("prefix-text", "t", "", "prefix text message")
+	bookmarkPostCmd.Flags().BoolP("feed-destinations", "", false, "Post to destinations in Feed list file (destinations option of each feed)")
 
 	return bookmarkPostCmd
 }
 
+// feedPostOptions is options for posting web page in feed.
+type feedPostOptions struct {
+	prefix       string
+	destinations []string
+	withImage    bool
+	visibility   string
+}
+
+// getFeedPostOptions function returns options for posting web page. Options in feed entry take precedence over flags.
+// Destinations in feed entry are used only if feedDests is true (--feed-destinations flag).
+func getFeedPostOptions(entry *webpage.FeedEntry, destinations []string, prefix string, withImage, feedDests bool) (*feedPostOptions, error) {
+	opts := &feedPostOptions{prefix: prefix, destinations: destinations, withImage: withImage}
+	if entry == nil {
+		return opts, nil
+	}
+	if len(entry.Prefix) > 0 {
+		opts.prefix = entry.Prefix
+	}
+	if feedDests && len(entry.Destinations) > 0 {
+		opts.destinations = entry.Destinations
+	}
+	if entry.WithImage != nil {
+		opts.withImage = *entry.WithImage
+	}
+	if len(entry.Visibility) > 0 {
+		if mastodon.GetVisibilityFrom(entry.Visibility) == mastodon.VisibilityUnknown {
+			return nil, errs.New("invalid visibility", errs.WithContext("feed_url", entry.URL), errs.WithContext("visibility", entry.Visibility))
+		}
+		opts.visibility = entry.Visibility
+	}
+	return opts, nil
+}
+
+// feedDestinations function returns destinations in feed entries of web pages.
+func feedDestinations(list []*webpage.Webpage) []string {
+	names := []string{}
+	for _, page := range list {
+		if entry := page.FeedEntry(); entry != nil {
+			for _, name := range entry.Destinations {
+				if !containsFold(names, name) {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+	return names
+}
+
 /* Copyright 2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
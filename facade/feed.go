@@ -1,12 +1,16 @@
 package facade
 
 import (
+	"strings"
+
 	"github.com/goark/errs"
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
 	"github.com/goark/toolbox/ecode"
 	"github.com/goark/toolbox/webpage"
+	"github.com/goark/toolbox/webpage/feed"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
 
@@ -22,13 +26,20 @@ func newFeedCmd(ui *rwi.RWI) *cobra.Command {
 		},
 	}
 	webpageCmd.PersistentFlags().StringP("url", "u", "", "Feed URL")
+	webpageCmd.PersistentFlags().StringArrayP("source", "", nil, "Photo source (type:id) ["+strings.Join(feed.SourceTypes(), "|")+"]")
 	webpageCmd.PersistentFlags().StringP("flickr-id", "", "", "Flickr ID")
-	webpageCmd.PersistentFlags().StringP("feed-list-file", "f", "", "path of Feed list file")
+	_ = webpageCmd.PersistentFlags().MarkDeprecated("flickr-id", "use --source flickr:<ID> instead")
+	webpageCmd.PersistentFlags().StringP("feed-list-file", "f", "", "path of Feed list file (text, OPML, YAML or TOML)")
+	webpageCmd.PersistentFlags().StringP("group", "g", "", "Group (tag) of feeds in Feed list file")
 	webpageCmd.PersistentFlags().BoolP("save", "", false, "Save webpage data to cache")
+	webpageCmd.PersistentFlags().BoolP("catch-up", "", false, "Mark all items as seen in feeds subscribed first time (use with --save)")
 
 	webpageCmd.AddCommand(
 		newFeedLookupCmd(ui),
 		newFeedPostCmd(ui),
+		newFeedImportCmd(ui),
+		newFeedExportCmd(ui),
+		newFeedDiscoverCmd(ui),
 	)
 	return webpageCmd
 }
@@ -38,23 +49,47 @@ func getFeedAll(cmd *cobra.Command, cfg *webpage.Config) ([]*webpage.Webpage, er
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	sources, err := cmd.Flags().GetStringArray("source")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 	flickrID, err := cmd.Flags().GetString("flickr-id")
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	if len(flickrID) > 0 {
+		sources = append(sources, "flickr:"+flickrID)
+	}
 	feedListPath, err := cmd.Flags().GetString("feed-list-file")
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
+	group, err := cmd.Flags().GetString("group")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	catchUp, err := cmd.Flags().GetBool("catch-up")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	cfg.SetCatchUp(catchUp)
+	// global filtering rules in config file
+	var rules []*feed.Rule
+	if err := viper.UnmarshalKey("feed.filters", &rules); err != nil {
+		return nil, errs.Wrap(feed.ErrInvalidFilter, errs.WithCause(err))
+	}
+	if err := cfg.SetFilter(rules); err != nil {
+		return nil, errs.Wrap(err)
+	}
 	errList := &errs.Errors{}
 	if len(urlStr) > 0 {
 		if err := cfg.Feed(cmd.Context(), urlStr); err != nil {
 			errList.Add(errs.Wrap(err, errs.WithContext("feed_url", urlStr)))
 		}
 	}
-	if len(flickrID) > 0 {
-		if err := cfg.FeedFlickr(cmd.Context(), flickrID); err != nil {
-			errList.Add(errs.Wrap(err, errs.WithContext("flickr_id", flickrID)))
+	for _, src := range sources {
+		if err := cfg.FeedSource(cmd.Context(), src); err != nil {
+			errList.Add(errs.Wrap(err, errs.WithContext("source", src)))
 		}
 	}
 	if len(feedListPath) > 0 {
@@ -62,7 +97,7 @@ func getFeedAll(cmd *cobra.Command, cfg *webpage.Config) ([]*webpage.Webpage, er
 		if err != nil {
 			errList.Add(errs.Wrap(err, errs.WithContext("feed_list_file", feedListPath)))
 		}
-		if err := fl.Parse(cmd.Context(), cfg); err != nil {
+		if err := fl.Filter(group).Parse(cmd.Context(), cfg); err != nil {
 			errList.Add(errs.Wrap(err, errs.WithContext("feed_list_file", feedListPath)))
 		}
 	}
@@ -1,9 +1,12 @@
 package facade
 
 import (
+	"context"
+
 	"github.com/goark/errs"
 	"github.com/goark/gocli/cache"
 	"github.com/goark/gocli/config"
+	"github.com/goark/toolbox/db"
 	"github.com/goark/toolbox/logger"
 	"github.com/goark/toolbox/tempdir"
 	"github.com/ipfs/go-log/v2"
@@ -17,9 +20,24 @@ type globalOptions struct {
 	bskyConfigPath  string
 	mstdnConfigPath string
 	apodConfigPath  string
+	repos           *db.Repository // opened database (see getRepository method)
 }
 
-func getGlobalOptions() (*globalOptions, error) {
+// globalOptionsKey is key of context for globalOptions shared by scheduled jobs (see serve command).
+type globalOptionsKey struct{}
+
+// withGlobalOptions function returns context with globalOptions shared by commands run in the context.
+func withGlobalOptions(ctx context.Context, gopts *globalOptions) context.Context {
+	return context.WithValue(ctx, globalOptionsKey{}, gopts)
+}
+
+// getGlobalOptions function returns global options. If context has shared globalOptions, returns it.
+func getGlobalOptions(ctx context.Context) (*globalOptions, error) {
+	if ctx != nil {
+		if gopts, ok := ctx.Value(globalOptionsKey{}).(*globalOptions); ok && gopts != nil {
+			return gopts, nil
+		}
+	}
 	cacheDir := viper.GetString("cache-dir")
 	if len(cacheDir) == 0 {
 		cacheDir = cache.Dir(Name)
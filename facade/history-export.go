@@ -0,0 +1,77 @@
+package facade
+
+import (
+	"os"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/history"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryExportCmd returns cobra.Command instance for show sub-command
+func newHistoryExportCmd(ui *rwi.RWI) *cobra.Command {
+	historyExportCmd := &cobra.Command{
+		Use:     "export",
+		Aliases: []string{"exp", "e"},
+		Short:   "Export history of posts",
+		Long:    "Export all history of posts matching conditions (JSON or CSV format).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			h, err := gopts.getHistory(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			cond, err := getHistoryCondition(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			format, err := getHistoryFormat(cmd, history.FormatJSON)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			path, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// export posts
+			list, err := h.List(cmd.Context(), cond)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if len(path) == 0 {
+				return debugPrint(ui, history.Encode(ui.Writer(), list, format))
+			}
+			file, err := os.Create(path)
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err, errs.WithContext("path", path)))
+			}
+			defer file.Close()
+			return debugPrint(ui, history.Encode(file, list, format))
+		},
+	}
+	historyExportCmd.Flags().StringP("output", "o", "", "Output file path (default: stdout)")
+
+	return historyExportCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
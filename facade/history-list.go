@@ -0,0 +1,67 @@
+package facade
+
+import (
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/history"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryListCmd returns cobra.Command instance for show sub-command
+func newHistoryListCmd(ui *rwi.RWI) *cobra.Command {
+	historyListCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls", "l"},
+		Short:   "List history of posts",
+		Long:    "List history of posts, ordered by newest first.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			h, err := gopts.getHistory(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			cond, err := getHistoryCondition(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			limit, err := cmd.Flags().GetInt("limit")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			cond.Limit = limit
+			format, err := getHistoryFormat(cmd, history.FormatTable)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// list posts
+			list, err := h.List(cmd.Context(), cond)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, history.Encode(ui.Writer(), list, format))
+		},
+	}
+	historyListCmd.Flags().IntP("limit", "", 20, "Maximum number of posts (0: no limit)")
+
+	return historyListCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
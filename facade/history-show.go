@@ -0,0 +1,65 @@
+package facade
+
+import (
+	"strconv"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/history"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryShowCmd returns cobra.Command instance for show sub-command
+func newHistoryShowCmd(ui *rwi.RWI) *cobra.Command {
+	historyShowCmd := &cobra.Command{
+		Use:     "show <id>",
+		Aliases: []string{"s"},
+		Short:   "Show detail of post",
+		Long:    "Show detail of post in history.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			h, err := gopts.getHistory(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			id, err := strconv.ParseUint(args[0], 10, 0)
+			if err != nil {
+				return debugPrint(ui, errs.Wrap(err, errs.WithContext("id", args[0])))
+			}
+			format, err := getHistoryFormat(cmd, history.FormatTable)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// show post
+			post, err := h.Get(cmd.Context(), uint(id))
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, post.Encode(ui.Writer(), format))
+		},
+	}
+
+	return historyShowCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
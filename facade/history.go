@@ -0,0 +1,130 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/history"
+	"github.com/spf13/cobra"
+)
+
+// newHistoryCmd returns cobra.Command instance for show sub-command
+func newHistoryCmd(ui *rwi.RWI) *cobra.Command {
+	historyCmd := &cobra.Command{
+		Use:     "history",
+		Aliases: []string{"hist"},
+		Short:   "Handling history of posts",
+		Long:    "Handling history of posts.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugPrint(ui, errs.Wrap(ecode.ErrNoCommand))
+		},
+	}
+	historyCmd.PersistentFlags().StringP("destination", "", "", "Destination of posts (Bluesky, Mastodon)")
+	historyCmd.PersistentFlags().StringP("source-type", "", "", "Source type of posts (apod, webpage, feed, calendar)")
+	historyCmd.PersistentFlags().StringP("text", "", "", "Text included in message or source of posts")
+	historyCmd.PersistentFlags().StringP("since", "", "", "Start of date for posts (YYYY-MM-DD)")
+	historyCmd.PersistentFlags().StringP("until", "", "", "End of date for posts (YYYY-MM-DD)")
+	historyCmd.PersistentFlags().StringP("format", "", "", fmt.Sprintf("Output format [%s]", strings.Join(history.FormatList(), "|")))
+
+	historyCmd.AddCommand(
+		newHistoryListCmd(ui),
+		newHistoryShowCmd(ui),
+		newHistoryExportCmd(ui),
+	)
+	return historyCmd
+}
+
+func (gopts *globalOptions) getHistory(ctx context.Context) (*history.History, error) {
+	repos, err := gopts.getRepository(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return history.NewWithRepository(repos, gopts.Logger), nil
+}
+
+// getHistoryFormat function returns output format from --format flag. If flag is empty, returns default format.
+func getHistoryFormat(cmd *cobra.Command, dflt history.Format) (history.Format, error) {
+	s, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return history.FormatUnknown, errs.Wrap(err)
+	}
+	if len(s) == 0 {
+		return dflt, nil
+	}
+	return history.FormatFrom(s)
+}
+
+// getHistoryCondition function returns condition for finding posts from flags.
+func getHistoryCondition(cmd *cobra.Command) (*db.PostHistoryCondition, error) {
+	destination, err := cmd.Flags().GetString("destination")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sourceType, err := cmd.Flags().GetString("source-type")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	text, err := cmd.Flags().GetString("text")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sinceStr, err := cmd.Flags().GetString("since")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	since, err := localDate(sinceStr)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("since", sinceStr))
+	}
+	untilStr, err := cmd.Flags().GetString("until")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	until, err := localDate(untilStr)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("until", untilStr))
+	}
+	if !until.IsZero() {
+		until = until.AddDate(0, 0, 1) // include the end of date
+	}
+	return &db.PostHistoryCondition{
+		Destination: destination,
+		SourceType:  sourceType,
+		Text:        text,
+		Since:       since,
+		Until:       until,
+	}, nil
+}
+
+// localDate function returns time.Time at the beginning of the date in local time zone.
+func localDate(s string) (time.Time, error) {
+	if len(s) == 0 {
+		return time.Time{}, nil
+	}
+	tm, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, errs.Wrap(err)
+	}
+	return time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, time.Local), nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
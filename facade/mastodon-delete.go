@@ -0,0 +1,54 @@
+package facade
+
+import (
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newMastodonDeleteCmd returns cobra.Command instance for show sub-command
+func newMastodonDeleteCmd(ui *rwi.RWI) *cobra.Command {
+	mastodonDeleteCmd := &cobra.Command{
+		Use:     "delete <url|id>",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete status in Mastodon",
+		Long:    "Delete status in Mastodon by status URL or ID.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			mstdn, err := gopts.getMastodon()
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// delete status
+			if err := mstdn.DeleteStatus(cmd.Context(), args[0]); err != nil {
+				mstdn.Logger().Error("error in mastodon.DeleteStatus", zap.Object("error", zapobject.New(err)))
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln("deleted:", args[0]))
+		},
+	}
+
+	return mastodonDeleteCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
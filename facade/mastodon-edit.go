@@ -0,0 +1,95 @@
+package facade
+
+import (
+	"strings"
+
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/mastodon"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newMastodonEditCmd returns cobra.Command instance for show sub-command
+func newMastodonEditCmd(ui *rwi.RWI) *cobra.Command {
+	mastodonEditCmd := &cobra.Command{
+		Use:     "edit <url|id>",
+		Aliases: []string{"ed"},
+		Short:   "Edit status in Mastodon",
+		Long:    "Edit text of status in Mastodon by status URL or ID.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			mstdn, err := gopts.getMastodon()
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			spoilerText, err := cmd.Flags().GetString("spoiler-text")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			msg, err := cmd.Flags().GetString("text")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			pipeFlag, err := cmd.Flags().GetBool("pipe")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			editFlag, err := cmd.Flags().GetBool("edit")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if pipeFlag {
+				msg, err = inputFromPipe(ui)
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+			} else if editFlag {
+				msg, err = editMessage(cmd.Context(), ui.Writer())
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+			}
+			msg = strings.TrimSpace(msg)
+
+			// edit status
+			resText, err := mstdn.EditStatus(cmd.Context(), args[0], &mastodon.Message{
+				Msg:         msg,
+				SpoilerText: spoilerText,
+			})
+			if err != nil {
+				mstdn.Logger().Error("error in mastodon.EditStatus", zap.Object("error", zapobject.New(err)))
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln(resText))
+		},
+	}
+	mastodonEditCmd.Flags().StringP("text", "t", "", "Text message")
+	mastodonEditCmd.Flags().BoolP("pipe", "", false, "Input from standard-input")
+	mastodonEditCmd.Flags().BoolP("edit", "", false, "Edit message")
+	mastodonEditCmd.MarkFlagsMutuallyExclusive("text", "pipe", "edit")
+	mastodonEditCmd.Flags().StringP("spoiler-text", "s", "", "Spoiler text (default: keep spoiler text of the status)")
+
+	return mastodonEditCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
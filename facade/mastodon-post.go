@@ -7,6 +7,7 @@ import (
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
 	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -20,7 +21,7 @@ func newMastodonPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post message to Mastodon.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -29,10 +30,15 @@ func newMastodonPostCmd(ui *rwi.RWI) *cobra.Command {
 				return debugPrint(ui, err)
 			}
 			// local options
-			images, err := cmd.Flags().GetStringSlice("image-file")
+			imageFiles, err := cmd.Flags().GetStringArray("image-file")
 			if err != nil {
 				return debugPrint(ui, err)
 			}
+			alts, err := cmd.Flags().GetStringArray("alt")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			images := poster.ParseImages(imageFiles, alts)
 			visStr, err := cmd.Flags().GetString("visibility")
 			if err != nil {
 				return debugPrint(ui, err)
@@ -49,6 +55,10 @@ func newMastodonPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
+			threadFlag, err := cmd.Flags().GetBool("thread")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
 			pipeFlag, err := cmd.Flags().GetBool("pipe")
 			if err != nil {
 				return debugPrint(ui, err)
@@ -75,7 +85,9 @@ func newMastodonPostCmd(ui *rwi.RWI) *cobra.Command {
 				Msg:         msg,
 				SpoilerText: spoilerText,
 				Visibility:  visibility.String(),
-				ImageFiles:  images,
+				ImageFiles:  images.Files(),
+				ImageAlts:   images.Alts(),
+				Thread:      threadFlag,
 			})
 			if err != nil {
 				mstdn.Logger().Error("error in mastodon.PostMessage", zap.Object("error", zapobject.New(err)))
@@ -88,9 +100,11 @@ func newMastodonPostCmd(ui *rwi.RWI) *cobra.Command {
 	mastodonPostCmd.Flags().BoolP("pipe", "", false, "Input from standard-input")
 	mastodonPostCmd.Flags().BoolP("edit", "", false, "Edit message")
 	mastodonPostCmd.MarkFlagsMutuallyExclusive("text", "pipe", "edit")
-	mastodonPostCmd.Flags().StringSliceP("image-file", "i", nil, "Image file")
+	mastodonPostCmd.Flags().StringArrayP("image-file", "i", nil, "Image file (path or path"+poster.AltSeparator+"alt-text)")
+	mastodonPostCmd.Flags().StringArrayP("alt", "", nil, "Alt text for image file (same order as --image-file)")
 	mastodonPostCmd.Flags().StringP("visibility", "v", mastodon.DefaultVisibility().String(), "Visibility ["+strings.Join(mastodon.VisibilityList(), "|")+"]")
 	mastodonPostCmd.Flags().StringP("spoiler-text", "s", "", "Spoiler text")
+	mastodonPostCmd.Flags().BoolP("thread", "", false, "Post long message as reply chain")
 
 	return mastodonPostCmd
 }
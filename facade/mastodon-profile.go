@@ -14,7 +14,7 @@ func newMastodonProfileCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Output my profile.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -24,7 +24,7 @@ func newMastodonRegisterCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Register Mastodon application.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
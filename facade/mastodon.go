@@ -25,6 +25,8 @@ func newMastodonCmd(ui *rwi.RWI) *cobra.Command {
 		newMastodonRegisterCmd(ui),
 		newMastodonProfileCmd(ui),
 		newMastodonPostCmd(ui),
+		newMastodonDeleteCmd(ui),
+		newMastodonEditCmd(ui),
 	)
 	return mastodonCmd
 }
@@ -0,0 +1,254 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
+	"github.com/goark/toolbox/queue"
+	"github.com/goark/toolbox/webpage"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// destination is definition of posting destination.
+type destination struct {
+	name      string
+	shorthand string
+	usage     string
+	newPoster func(ctx context.Context, gopts *globalOptions, wcfg *webpage.Config) (poster.Poster, error)
+}
+
+// destinations is list of posting destinations. Add new destination to this list.
+var destinations = []destination{
+	{
+		name:      "bluesky",
+		shorthand: "b",
+		usage:     "Post to bluesky",
+		newPoster: func(ctx context.Context, gopts *globalOptions, wcfg *webpage.Config) (poster.Poster, error) {
+			if wcfg == nil {
+				wp, err := gopts.getWebpage(ctx)
+				if err != nil {
+					return nil, errs.Wrap(err)
+				}
+				wcfg = wp
+			}
+			return gopts.getBluesky(wcfg)
+		},
+	},
+	{
+		name:      "mastodon",
+		shorthand: "m",
+		usage:     "Post to Mastodon",
+		newPoster: func(_ context.Context, gopts *globalOptions, _ *webpage.Config) (poster.Poster, error) {
+			return gopts.getMastodon()
+		},
+	},
+}
+
+// addPosterFlags function adds flags of posting destinations to command.
+func addPosterFlags(cmd *cobra.Command) {
+	for _, d := range destinations {
+		cmd.Flags().BoolP(d.name, d.shorthand, false, d.usage)
+	}
+	cmd.Flags().BoolP("allow-duplicate", "", false, "Post even if the same content was already posted")
+	cmd.Flags().BoolP("dry-run", "", false, "Output final payloads without posting")
+	cmd.Flags().BoolP("enqueue", "", false, "Add message to post queue instead of posting")
+	cmd.Flags().StringP("not-before", "", "", "Enqueue message not posted before the time (duration, RFC 3339 or \"YYYY-MM-DD HH:MM\")")
+	cmd.Flags().BoolP("json", "j", false, "Output JSON format (with --dry-run)")
+}
+
+// getDispatcher method returns poster.Dispatcher instance with destinations selected by flags (and names in others).
+// Errors in configuration of each destination are returned as list, and do not stop other destinations.
+// Posts are recorded in post history, and the same content is not posted twice unless --allow-duplicate flag is set.
+// With --dry-run flag, post history is not used.
+func (gopts *globalOptions) getDispatcher(cmd *cobra.Command, wcfg *webpage.Config, others ...string) (*poster.Dispatcher, []error) {
+	dispatcher := poster.NewDispatcher(gopts.Logger)
+	var errList []error
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return dispatcher, []error{errs.Wrap(err)}
+	}
+	allowDuplicate, err := cmd.Flags().GetBool("allow-duplicate")
+	if err != nil {
+		return dispatcher, []error{errs.Wrap(err)}
+	}
+	if !dryRun {
+		history, err := gopts.getHistory(cmd.Context())
+		switch {
+		case err == nil:
+			dispatcher.SetHistory(history, allowDuplicate)
+		case allowDuplicate:
+			// posting without checking duplicates is allowed, so post without history
+			gopts.Logger.Desugar().Warn("cannot open post history, post without recording history", zap.Object("error", zapobject.New(errs.Wrap(err))))
+		default:
+			return dispatcher, []error{errs.Wrap(err)}
+		}
+	}
+	for _, name := range others {
+		if !isDestination(name) {
+			errList = append(errList, errs.Wrap(ecode.ErrNoDestination, errs.WithContext("destination", name)))
+		}
+	}
+	for _, d := range destinations {
+		flag, err := cmd.Flags().GetBool(d.name)
+		if err != nil {
+			errList = append(errList, errs.Wrap(err, errs.WithContext("destination", d.name)))
+			continue
+		}
+		if !flag && !containsFold(others, d.name) {
+			continue
+		}
+		p, err := d.newPoster(cmd.Context(), gopts, wcfg)
+		if err != nil {
+			err = errs.Wrap(err, errs.WithContext("destination", d.name))
+			gopts.Logger.Desugar().Info("no configuration for destination", zap.String("destination", d.name), zap.Object("error", zapobject.New(err)))
+			errList = append(errList, err)
+			continue
+		}
+		dispatcher.Add(p)
+	}
+	return dispatcher, errList
+}
+
+// getDestinationNames function returns names of destinations selected by flags.
+func getDestinationNames(cmd *cobra.Command) ([]string, error) {
+	names := []string{}
+	for _, d := range destinations {
+		flag, err := cmd.Flags().GetBool(d.name)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("destination", d.name))
+		}
+		if flag {
+			names = append(names, d.name)
+		}
+	}
+	return names, nil
+}
+
+func isDestination(name string) bool {
+	for _, d := range destinations {
+		if strings.EqualFold(d.name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchMessage method posts message to destinations, and outputs results.
+// If --dry-run flag is set, outputs final payloads for destinations instead of posting.
+// If --enqueue or --not-before flag is set, adds message to post queue instead of posting.
+func (gopts *globalOptions) dispatchMessage(cmd *cobra.Command, ui *rwi.RWI, dispatcher *poster.Dispatcher, msg *poster.Message) []error {
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	if dryRun {
+		jsonFlag, err := cmd.Flags().GetBool("json")
+		if err != nil {
+			return []error{errs.Wrap(err)}
+		}
+		return outputPreviews(ui, dispatcher.Preview(cmd.Context(), msg), jsonFlag)
+	}
+	enqueueFlag, err := cmd.Flags().GetBool("enqueue")
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	notBeforeStr, err := cmd.Flags().GetString("not-before")
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	if enqueueFlag || len(notBeforeStr) > 0 {
+		return gopts.enqueueMessage(cmd, ui, dispatcher, msg, notBeforeStr)
+	}
+	return outputResults(ui, dispatcher.Post(cmd.Context(), msg))
+}
+
+// enqueueMessage method adds message to post queue for destinations.
+func (gopts *globalOptions) enqueueMessage(cmd *cobra.Command, ui *rwi.RWI, dispatcher *poster.Dispatcher, msg *poster.Message, notBeforeStr string) []error {
+	if dispatcher.Len() == 0 {
+		return nil
+	}
+	notBefore, err := queue.ParseTime(notBeforeStr, time.Now())
+	if err != nil {
+		return []error{errs.Wrap(err, errs.WithContext("not_before", notBeforeStr))}
+	}
+	allowDuplicate, err := cmd.Flags().GetBool("allow-duplicate")
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	q, err := gopts.getQueue(cmd.Context())
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	items, err := q.Enqueue(cmd.Context(), dispatcher.Names(), msg, notBefore, allowDuplicate)
+	if err != nil {
+		return []error{errs.Wrap(err)}
+	}
+	for _, item := range items {
+		_ = ui.Outputln(fmt.Sprintf("enqueue to %s: id %d (not before %s)", item.Destination, item.ID, item.NotBefore.Local().Format(time.RFC3339)))
+	}
+	return nil
+}
+
+// outputPreviews function outputs final payloads for destinations, and returns errors in previews.
+func outputPreviews(ui *rwi.RWI, previews []*poster.Preview, jsonFlag bool) []error {
+	var errList []error
+	for _, pv := range previews {
+		if pv.Err != nil {
+			errList = append(errList, pv.Err)
+			continue
+		}
+		if err := pv.Encode(ui.Writer(), jsonFlag); err != nil {
+			errList = append(errList, err)
+		}
+	}
+	return errList
+}
+
+// outputResults function outputs results of posting, and returns errors in results.
+func outputResults(ui *rwi.RWI, results []*poster.Result) []error {
+	var errList []error
+	for _, res := range results {
+		if res.Err != nil {
+			errList = append(errList, res.Err)
+			continue
+		}
+		if res.Skipped {
+			_ = ui.Outputln("skip posting to "+res.Name+" (already posted):", res.URI)
+			continue
+		}
+		_ = ui.Outputln("post to "+res.Name+":", res.URI)
+	}
+	return errList
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,65 @@
+package facade
+
+import (
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/queue"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newQueueDaemonCmd returns cobra.Command instance for show sub-command
+func newQueueDaemonCmd(ui *rwi.RWI) *cobra.Command {
+	queueDaemonCmd := &cobra.Command{
+		Use:     "daemon",
+		Aliases: []string{"d"},
+		Short:   "Deliver queued messages periodically",
+		Long:    "Deliver queued messages periodically until interrupted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			runner, err := gopts.getQueueRunner(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			jsonFlag, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// deliver messages periodically
+			return debugPrint(ui, runner.Daemon(cmd.Context(), interval, func(items []*queue.Item) {
+				if err := queue.Encode(ui.Writer(), items, jsonFlag); err != nil {
+					gopts.Logger.Desugar().Error("error in output", zap.Object("error", zapobject.New(err)))
+				}
+			}))
+		},
+	}
+	queueDaemonCmd.Flags().DurationP("interval", "", queue.DefaultInterval, "Interval of delivery")
+	queueDaemonCmd.Flags().IntP("max-attempts", "", queue.DefaultMaxAttempts, "Maximum number of attempts for each message")
+	queueDaemonCmd.Flags().BoolP("json", "j", false, "Output JSON format")
+
+	return queueDaemonCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
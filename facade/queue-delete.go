@@ -0,0 +1,55 @@
+package facade
+
+import (
+	"github.com/goark/gocli/rwi"
+	"github.com/spf13/cobra"
+)
+
+// newQueueDeleteCmd returns cobra.Command instance for show sub-command
+func newQueueDeleteCmd(ui *rwi.RWI) *cobra.Command {
+	queueDeleteCmd := &cobra.Command{
+		Use:     "delete <id>",
+		Aliases: []string{"del", "rm"},
+		Short:   "Delete message in queue",
+		Long:    "Delete message and its image files in queue.",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			q, err := gopts.getQueue(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			id, err := getQueueID(args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// delete item
+			if err := q.Delete(cmd.Context(), id); err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, ui.Outputln("deleted:", id))
+		},
+	}
+
+	return queueDeleteCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
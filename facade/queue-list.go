@@ -0,0 +1,63 @@
+package facade
+
+import (
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/queue"
+	"github.com/spf13/cobra"
+)
+
+// newQueueListCmd returns cobra.Command instance for show sub-command
+func newQueueListCmd(ui *rwi.RWI) *cobra.Command {
+	queueListCmd := &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls", "l"},
+		Short:   "List queued messages",
+		Long:    "List queued messages (pending, done and failed).",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			q, err := gopts.getQueue(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			// local options
+			status, err := cmd.Flags().GetString("status")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			jsonFlag, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// list items
+			items, err := q.List(cmd.Context(), status)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, queue.Encode(ui.Writer(), items, jsonFlag))
+		},
+	}
+	queueListCmd.Flags().StringP("status", "s", "", "Status of messages ["+queue.StatusPending+"|"+queue.StatusRunning+"|"+queue.StatusDone+"|"+queue.StatusFailed+"]")
+	queueListCmd.Flags().BoolP("json", "j", false, "Output JSON format")
+
+	return queueListCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,56 @@
+package facade
+
+import (
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/queue"
+	"github.com/spf13/cobra"
+)
+
+// newQueueRetryCmd returns cobra.Command instance for show sub-command
+func newQueueRetryCmd(ui *rwi.RWI) *cobra.Command {
+	queueRetryCmd := &cobra.Command{
+		Use:   "retry <id>",
+		Short: "Retry failed message",
+		Long:  "Reset failed message in queue to deliver again by next run.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			q, err := gopts.getQueue(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			id, err := getQueueID(args[0])
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// reset item
+			item, err := q.Retry(cmd.Context(), id)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			return debugPrint(ui, queue.Encode(ui.Writer(), []*queue.Item{item}, false))
+		},
+	}
+
+	return queueRetryCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
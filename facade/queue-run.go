@@ -0,0 +1,60 @@
+package facade
+
+import (
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/queue"
+	"github.com/spf13/cobra"
+)
+
+// newQueueRunCmd returns cobra.Command instance for show sub-command
+func newQueueRunCmd(ui *rwi.RWI) *cobra.Command {
+	queueRunCmd := &cobra.Command{
+		Use:     "run",
+		Aliases: []string{"r"},
+		Short:   "Deliver queued messages",
+		Long:    "Deliver queued messages which are due, and exit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			runner, err := gopts.getQueueRunner(cmd)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			jsonFlag, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// deliver messages
+			items, err := runner.Run(cmd.Context())
+			if len(items) > 0 {
+				if err := queue.Encode(ui.Writer(), items, jsonFlag); err != nil {
+					return debugPrint(ui, err)
+				}
+			}
+			return debugPrint(ui, err)
+		},
+	}
+	queueRunCmd.Flags().IntP("max-attempts", "", queue.DefaultMaxAttempts, "Maximum number of attempts for each message")
+	queueRunCmd.Flags().BoolP("json", "j", false, "Output JSON format")
+
+	return queueRunCmd
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
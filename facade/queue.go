@@ -0,0 +1,99 @@
+package facade
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
+	"github.com/goark/toolbox/queue"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// newQueueCmd returns cobra.Command instance for show sub-command
+func newQueueCmd(ui *rwi.RWI) *cobra.Command {
+	queueCmd := &cobra.Command{
+		Use:     "queue",
+		Aliases: []string{"q", "outbox"},
+		Short:   "Handling post queue",
+		Long:    "Handling post queue (outbox). Messages are enqueued by --enqueue or --not-before flag of post commands.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return debugPrint(ui, errs.Wrap(ecode.ErrNoCommand))
+		},
+	}
+
+	queueCmd.AddCommand(
+		newQueueRunCmd(ui),
+		newQueueDaemonCmd(ui),
+		newQueueListCmd(ui),
+		newQueueRetryCmd(ui),
+		newQueueDeleteCmd(ui),
+	)
+	return queueCmd
+}
+
+func (gopts *globalOptions) getQueue(ctx context.Context) (*queue.Queue, error) {
+	repos, err := gopts.getRepository(ctx)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return queue.NewWithRepository(gopts.CacheDir, repos, gopts.Logger), nil
+}
+
+// getQueueRunner method returns queue.Runner instance with all configured destinations.
+func (gopts *globalOptions) getQueueRunner(cmd *cobra.Command) (*queue.Runner, error) {
+	q, err := gopts.getQueue(cmd.Context())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	h, err := gopts.getHistory(cmd.Context())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var posters []poster.Poster
+	for _, d := range destinations {
+		p, err := d.newPoster(cmd.Context(), gopts, nil)
+		if err != nil {
+			gopts.Logger.Desugar().Info("no configuration for destination", zap.String("destination", d.name), zap.Object("error", zapobject.New(errs.Wrap(err))))
+			continue
+		}
+		posters = append(posters, p)
+	}
+	runner := q.NewRunner(h, posters...)
+	maxAttempts, err := cmd.Flags().GetInt("max-attempts")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if maxAttempts > 0 {
+		runner.MaxAttempts = maxAttempts
+	}
+	return runner, nil
+}
+
+// getQueueID function returns ID of queue item from argument.
+func getQueueID(s string) (uint, error) {
+	id, err := strconv.ParseUint(s, 10, 0)
+	if err != nil {
+		return 0, errs.Wrap(err, errs.WithContext("id", s))
+	}
+	return uint(id), nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,118 @@
+package facade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/schedule"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// newServeCmd returns cobra.Command instance for show sub-command
+func newServeCmd(ui *rwi.RWI) *cobra.Command {
+	serveCmd := &cobra.Command{
+		Use:     "serve",
+		Aliases: []string{"daemon"},
+		Short:   "Run scheduled jobs in config file",
+		Long:    "Run scheduled jobs in config file (schedule section) until interrupted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Global options
+			gopts, err := getGlobalOptions(cmd.Context())
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			listFlag, err := cmd.Flags().GetBool("list")
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// load schedule
+			var cfg schedule.Config
+			if err := viper.UnmarshalKey("schedule", &cfg); err != nil {
+				return debugPrint(ui, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithCause(err)))
+			}
+			sched, err := schedule.New(&cfg, gopts.Logger)
+			if err != nil {
+				return debugPrint(ui, err)
+			}
+			if listFlag {
+				now := time.Now().In(sched.Location())
+				for _, j := range sched.Jobs() {
+					if err := ui.Outputln(fmt.Sprintf("%s\tnext: %s", j, j.First(now).Format(time.RFC3339))); err != nil {
+						return debugPrint(ui, err)
+					}
+				}
+				return nil
+			}
+
+			// commands of jobs (flags are parsed once)
+			jobCmds := map[*schedule.Job]*cobra.Command{}
+			for _, j := range sched.Jobs() {
+				c, err := newJobCmd(ui, j)
+				if err != nil {
+					return debugPrint(ui, err)
+				}
+				jobCmds[j] = c
+			}
+			// database is opened once, and global options are shared by all jobs
+			if _, err := gopts.getRepository(cmd.Context()); err != nil {
+				return debugPrint(ui, err)
+			}
+
+			// run jobs in this process
+			ctx := withGlobalOptions(cmd.Context(), gopts)
+			return debugPrint(ui, sched.Start(ctx, func(ctx context.Context, job *schedule.Job) error {
+				c := jobCmds[job]
+				c.SetContext(ctx)
+				return c.RunE(c, c.Flags().Args())
+			}))
+		},
+	}
+	serveCmd.Flags().BoolP("list", "", false, "List scheduled jobs and exit")
+
+	return serveCmd
+}
+
+// newJobCmd function returns sub-command for args of scheduled job, with flags parsed.
+// Global options (flags of root command) cannot be used in args, because global options of serve command are shared by all jobs.
+func newJobCmd(ui *rwi.RWI, job *schedule.Job) (*cobra.Command, error) {
+	root := &cobra.Command{Use: Name}
+	root.AddCommand(subCommands(ui)...)
+	c, rest, err := root.Find(job.Args)
+	if err != nil {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithCause(err), errs.WithContext("job", job.Name))
+	}
+	if c == root || c.RunE == nil {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", job.Name), errs.WithContext("detail", "no command"))
+	}
+	if err := c.ParseFlags(rest); err != nil {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithCause(err), errs.WithContext("job", job.Name))
+	}
+	if err := c.ValidateArgs(c.Flags().Args()); err != nil {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithCause(err), errs.WithContext("job", job.Name))
+	}
+	if err := c.ValidateRequiredFlags(); err != nil {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithCause(err), errs.WithContext("job", job.Name))
+	}
+	return c, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,74 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/goark/gocli/rwi"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/schedule"
+)
+
+func TestNewJobCmd(t *testing.T) {
+	testCases := []struct {
+		args []string
+		name string
+		err  error
+	}{
+		{args: []string{"feed", "post", "--url", "https://example.com/feed.xml", "--dry-run"}, name: "post"},
+		{args: []string{"queue", "retry", "1"}, name: "retry"},
+		{args: []string{"unknown"}, err: ecode.ErrInvalidSchedule},
+		{args: []string{"feed", "post", "--log-level=debug"}, err: ecode.ErrInvalidSchedule}, // global option
+		{args: []string{"queue", "retry"}, err: ecode.ErrInvalidSchedule},                    // no args
+		{args: []string{"feed", "post", "--no-such-flag"}, err: ecode.ErrInvalidSchedule},
+	}
+	for _, tc := range testCases {
+		c, err := newJobCmd(rwi.New(), &schedule.Job{Name: "test", Args: tc.args})
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("newJobCmd(%q) error = \"%+v\", want \"%+v\".", tc.args, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("newJobCmd(%q) error = \"%+v\", want nil.", tc.args, err)
+			continue
+		}
+		if c.Name() != tc.name {
+			t.Errorf("newJobCmd(%q) = %v, want %v.", tc.args, c.Name(), tc.name)
+		}
+	}
+
+	// flags of job are parsed
+	c, err := newJobCmd(rwi.New(), &schedule.Job{Name: "test", Args: []string{"feed", "post", "--url", "https://example.com/feed.xml"}})
+	if err != nil {
+		t.Fatalf("newJobCmd() error = \"%+v\", want nil.", err)
+	}
+	if u, err := c.Flags().GetString("url"); err != nil || u != "https://example.com/feed.xml" {
+		t.Errorf("--url flag = %q (error %v), want %q.", u, err, "https://example.com/feed.xml")
+	}
+}
+
+func TestGetGlobalOptionsShared(t *testing.T) {
+	gopts := &globalOptions{CacheDir: "/tmp/cache"}
+	got, err := getGlobalOptions(withGlobalOptions(context.Background(), gopts))
+	if err != nil || got != gopts {
+		t.Errorf("getGlobalOptions() = %+v (error %v), want shared options %+v.", got, err, gopts)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -16,7 +16,7 @@ func newBookmarkLookupCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Lookup information for Web page.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -33,6 +33,9 @@ func newBookmarkLookupCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
+			if err := setCacheOptions(cmd, cfg); err != nil {
+				return debugPrint(ui, err)
+			}
 
 			// lookup Web page data
 			page, err := cfg.Lookup(cmd.Context(), urlStr)
@@ -8,8 +8,7 @@ import (
 	"github.com/goark/errs"
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/gocli/rwi"
-	"github.com/goark/toolbox/bluesky"
-	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 )
@@ -23,7 +22,7 @@ func newBookmarkPostCmd(ui *rwi.RWI) *cobra.Command {
 		Long:    "Post Web page's information to time lines.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Global options
-			gopts, err := getGlobalOptions()
+			gopts, err := getGlobalOptions(cmd.Context())
 			if err != nil {
 				return debugPrint(ui, err)
 			}
@@ -40,12 +39,7 @@ func newBookmarkPostCmd(ui *rwi.RWI) *cobra.Command {
 			if err != nil {
 				return debugPrint(ui, err)
 			}
-			bskyFlag, err := cmd.Flags().GetBool("bluesky")
-			if err != nil {
-				return debugPrint(ui, err)
-			}
-			mastodonFlag, err := cmd.Flags().GetBool("mastodon")
-			if err != nil {
+			if err := setCacheOptions(cmd, cfg); err != nil {
 				return debugPrint(ui, err)
 			}
 			withImage, err := cmd.Flags().GetBool("with-image")
@@ -66,7 +60,7 @@ func newBookmarkPostCmd(ui *rwi.RWI) *cobra.Command {
 
 			// get image file
 			gopts.Logger.Desugar().Debug("start posting web page info", zap.Any("info", page))
-			var imgs []string
+			var imgs []*poster.Image
 			if withImage && len(page.ImageURL) > 0 {
 				fname, err := page.ImageFile(cmd.Context(), gopts.CacheDir)
 				if err != nil {
@@ -75,39 +69,20 @@ func newBookmarkPostCmd(ui *rwi.RWI) *cobra.Command {
 				if len(fname) > 0 {
 					gopts.Logger.Desugar().Debug("downloaded image file", zap.String("url", page.ImageURL), zap.String("local", fname))
 					defer os.Remove(fname)
-					imgs = []string{fname}
+					imgs = []*poster.Image{{Path: fname, Alt: page.AltText()}}
 				}
 			}
 
 			// make message
 			msg := page.MakeMessage(strings.TrimSpace(pmsg))
 
-			var lastErrs []error
-
-			// post to Bluesky
-			if bskyFlag {
-				if bsky, err := gopts.getBluesky(cfg); err != nil {
-					cfg.Logger().Info("no Bluesky configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := bsky.PostMessage(cmd.Context(), &bluesky.Message{Msg: msg, ImageFiles: imgs}); err != nil {
-					bsky.Logger().Error("error in bluesky.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Bluesky:", resText)
-				}
-			}
-			// post to Mastodon
-			if mastodonFlag {
-				if mstdn, err := gopts.getMastodon(); err != nil {
-					cfg.Logger().Info("no Mastodon configuration", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else if resText, err := mstdn.PostMessage(cmd.Context(), &mastodon.Message{Msg: msg, ImageFiles: imgs}); err != nil {
-					mstdn.Logger().Error("error in mastodon.PostMessage", zap.Object("error", zapobject.New(err)))
-					lastErrs = append(lastErrs, err)
-				} else {
-					_ = ui.Outputln("post to Mastodon:", resText)
-				}
-			}
+			// post message
+			dispatcher, lastErrs := gopts.getDispatcher(cmd, cfg)
+			lastErrs = append(lastErrs, gopts.dispatchMessage(cmd, ui, dispatcher, &poster.Message{
+				Text:   msg,
+				Images: imgs,
+				Source: poster.Source{Type: poster.SourceWebpage, ID: page.URL},
+			})...)
 
 			if len(lastErrs) > 0 {
 				return debugPrint(ui, errs.Wrap(errors.Join(lastErrs...)))
@@ -127,8 +102,7 @@ func newBookmarkPostCmd(ui *rwi.RWI) *cobra.Command {
 			return nil
 		},
 	}
-	bookmarkPostCmd.Flags().BoolP("bluesky", "b", false, "Post to bluesky")
-	bookmarkPostCmd.Flags().BoolP("mastodon", "m", false, "Post to Mastodon")
+	addPosterFlags(bookmarkPostCmd)
 	bookmarkPostCmd.Flags().BoolP("with-image", "", false, "Post with image")
 	bookmarkPostCmd.Flags().StringP("prefix-text", "t", "", "prefix text message")
 
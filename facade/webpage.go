@@ -24,6 +24,8 @@ func newWebpageCmd(ui *rwi.RWI) *cobra.Command {
 	webpageCmd.PersistentFlags().StringP("url", "u", "", "Web page URL")
 	_ = webpageCmd.MarkFlagRequired("url")
 	webpageCmd.PersistentFlags().BoolP("save", "", false, "Save page data to cache")
+	webpageCmd.PersistentFlags().BoolP("refresh", "", false, "Fetch Web page again if cached data is older than TTL (use with --save to update cache)")
+	webpageCmd.PersistentFlags().DurationP("cache-ttl", "", webpage.DefaultCacheTTL, "TTL of cached page data (0 is no expiration)")
 
 	webpageCmd.AddCommand(
 		newBookmarkLookupCmd(ui),
@@ -33,11 +35,26 @@ func newWebpageCmd(ui *rwi.RWI) *cobra.Command {
 }
 
 func (gopts *globalOptions) getWebpage(ctx context.Context) (*webpage.Config, error) {
-	cfg, err := webpage.New(ctx, gopts.CacheDir, gopts.Logger)
+	repos, err := gopts.getRepository(ctx)
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
-	return cfg, nil
+	return webpage.NewWithRepository(gopts.CacheDir, repos, gopts.Logger), nil
+}
+
+// setCacheOptions function sets options of cache (--refresh and --cache-ttl flags) to webpage.Config.
+func setCacheOptions(cmd *cobra.Command, cfg *webpage.Config) error {
+	refresh, err := cmd.Flags().GetBool("refresh")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	ttl, err := cmd.Flags().GetDuration("cache-ttl")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	cfg.SetRefresh(refresh)
+	cfg.SetCacheTTL(ttl)
+	return nil
 }
 
 /* Copyright 2023 Spiegel
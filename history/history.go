@@ -0,0 +1,132 @@
+package history
+
+import (
+	"context"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/poster"
+	"github.com/ipfs/go-log/v2"
+	"go.uber.org/zap"
+)
+
+// History is post history in database.
+type History struct {
+	repos  *db.Repository
+	logger *log.ZapEventLogger
+}
+
+var _ poster.History = (*History)(nil)
+
+// New function creates new History instance.
+func New(ctx context.Context, cacheDir string, logger *log.ZapEventLogger) (*History, error) {
+	repos, err := db.Open(ctx, cacheDir, logger)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("cache_dir", cacheDir))
+	}
+	return NewWithRepository(repos, logger), nil
+}
+
+// NewWithRepository function creates new History instance with opened database.
+func NewWithRepository(repos *db.Repository, logger *log.ZapEventLogger) *History {
+	return &History{repos: repos, logger: logger}
+}
+
+// Logger method returns zap.Logger instance.
+func (h *History) Logger() *zap.Logger {
+	if h == nil || h.logger == nil {
+		return logger.Nop().Desugar()
+	}
+	return h.logger.Desugar()
+}
+
+// FindPosted method returns the latest record of same content posted to destination (poster.History interface).
+func (h *History) FindPosted(ctx context.Context, destination, contentHash string) (*poster.Record, error) {
+	if h == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := h.repos.FindPostHistory(ctx, destination, contentHash)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	return &poster.Record{
+		Destination: data.Destination,
+		URI:         data.URI,
+		SourceType:  data.SourceType,
+		Source:      data.Source,
+		ContentHash: data.ContentHash,
+		Text:        data.Text,
+		PostedAt:    data.PostedAt,
+	}, nil
+}
+
+// AddPosted method stores record of posted message (poster.History interface).
+func (h *History) AddPosted(ctx context.Context, rec *poster.Record) error {
+	if h == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if err := h.repos.InsertPostHistory(ctx, &model.PostHistory{
+		Destination: rec.Destination,
+		URI:         rec.URI,
+		SourceType:  rec.SourceType,
+		Source:      rec.Source,
+		ContentHash: rec.ContentHash,
+		Text:        rec.Text,
+		PostedAt:    rec.PostedAt,
+	}); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+// List method returns list of posts by condition, ordered by newest first.
+func (h *History) List(ctx context.Context, cond *db.PostHistoryCondition) ([]*Post, error) {
+	if h == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := h.repos.FindPostHistories(ctx, cond)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	list := make([]*Post, 0, len(data))
+	for _, d := range data {
+		list = append(list, newPost(&d))
+	}
+	return list, nil
+}
+
+// Get method returns post by ID.
+func (h *History) Get(ctx context.Context, id uint) (*Post, error) {
+	if h == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := h.repos.FindPostHistoryByID(ctx, id)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("id", id))
+	}
+	if data == nil {
+		return nil, errs.Wrap(ecode.ErrNoContent, errs.WithContext("id", id))
+	}
+	return newPost(data), nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
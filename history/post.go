@@ -0,0 +1,194 @@
+package history
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+)
+
+// Post is information of posted message.
+type Post struct {
+	ID          uint      `json:"id"`
+	PostedAt    time.Time `json:"posted_at"`
+	Destination string    `json:"destination"`
+	URI         string    `json:"uri"`
+	SourceType  string    `json:"source_type,omitempty"`
+	Source      string    `json:"source,omitempty"`
+	ContentHash string    `json:"content_hash"`
+	Text        string    `json:"text"`
+}
+
+func newPost(data *model.PostHistory) *Post {
+	return &Post{
+		ID:          data.ID,
+		PostedAt:    data.PostedAt,
+		Destination: data.Destination,
+		URI:         data.URI,
+		SourceType:  data.SourceType,
+		Source:      data.Source,
+		ContentHash: data.ContentHash,
+		Text:        data.Text,
+	}
+}
+
+// Format is output format of posts.
+type Format int
+
+const (
+	FormatUnknown Format = iota
+	FormatTable
+	FormatJSON
+	FormatCSV
+)
+
+var formatMap = map[Format]string{
+	FormatTable: "table",
+	FormatJSON:  "json",
+	FormatCSV:   "csv",
+}
+
+// FormatList function returns list of Format strings.
+func FormatList() []string {
+	return []string{formatMap[FormatTable], formatMap[FormatJSON], formatMap[FormatCSV]}
+}
+
+// FormatFrom function returns Format from string.
+func FormatFrom(s string) (Format, error) {
+	for k, v := range formatMap {
+		if strings.EqualFold(v, s) {
+			return k, nil
+		}
+	}
+	return FormatUnknown, errs.Wrap(ecode.ErrInvalidFormat, errs.WithContext("format", s))
+}
+
+func (f Format) String() string {
+	if s, ok := formatMap[f]; ok {
+		return s
+	}
+	return ""
+}
+
+const (
+	timeLayout    = "2006-01-02 15:04:05"
+	maxTextLength = 40
+)
+
+var csvHeader = []string{"id", "posted_at", "destination", "uri", "source_type", "source", "content_hash", "text"}
+
+// Encode function outputs list of posts to io.Writer by format.
+func Encode(w io.Writer, list []*Post, format Format) error {
+	switch format {
+	case FormatTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tPOSTED AT\tDESTINATION\tSOURCE\tTEXT\tURI")
+		for _, p := range list {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", p.ID, p.PostedAt.Local().Format(timeLayout), p.Destination, p.sourceString(), summary(p.Text, maxTextLength), p.URI)
+		}
+		if err := tw.Flush(); err != nil {
+			return errs.Wrap(err)
+		}
+	case FormatJSON:
+		if list == nil {
+			list = []*Post{}
+		}
+		if err := json.NewEncoder(w).Encode(list); err != nil {
+			return errs.Wrap(err)
+		}
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(csvHeader); err != nil {
+			return errs.Wrap(err)
+		}
+		for _, p := range list {
+			if err := cw.Write([]string{
+				strconv.FormatUint(uint64(p.ID), 10),
+				p.PostedAt.Format(time.RFC3339),
+				p.Destination,
+				p.URI,
+				p.SourceType,
+				p.Source,
+				p.ContentHash,
+				p.Text,
+			}); err != nil {
+				return errs.Wrap(err)
+			}
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return errs.Wrap(err)
+		}
+	default:
+		return errs.Wrap(ecode.ErrInvalidFormat, errs.WithContext("format", format))
+	}
+	return nil
+}
+
+// Encode method outputs detail of post to io.Writer by format.
+func (p *Post) Encode(w io.Writer, format Format) error {
+	if p == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	switch format {
+	case FormatTable:
+		fmt.Fprintln(w, "ID:", p.ID)
+		fmt.Fprintln(w, "Posted at:", p.PostedAt.Local().Format(timeLayout))
+		fmt.Fprintln(w, "Destination:", p.Destination)
+		fmt.Fprintln(w, "URI:", p.URI)
+		fmt.Fprintln(w, "Source:", p.sourceString())
+		fmt.Fprintln(w, "Content hash:", p.ContentHash)
+		fmt.Fprintf(w, "\n%s\n", p.Text)
+	case FormatJSON:
+		if err := json.NewEncoder(w).Encode(p); err != nil {
+			return errs.Wrap(err)
+		}
+	default:
+		return Encode(w, []*Post{p}, format)
+	}
+	return nil
+}
+
+func (p *Post) sourceString() string {
+	switch {
+	case len(p.SourceType) == 0:
+		return p.Source
+	case len(p.Source) == 0:
+		return p.SourceType
+	default:
+		return p.SourceType + ":" + p.Source
+	}
+}
+
+// summary function returns the first line of text within max characters.
+func summary(text string, max int) string {
+	text, _, _ = strings.Cut(strings.TrimSpace(text), "\n")
+	rs := []rune(text)
+	if len(rs) <= max {
+		return text
+	}
+	return string(rs[:max-1]) + "…"
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
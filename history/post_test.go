@@ -0,0 +1,75 @@
+package history_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/history"
+)
+
+func TestEncode(t *testing.T) {
+	list := []*history.Post{
+		{ID: 1, PostedAt: time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC), Destination: "Bluesky", URI: "at://foo/1", SourceType: "apod", Source: "2026-10-17", ContentHash: "abc", Text: "hello, \"world\""},
+	}
+	testCases := []struct {
+		format history.Format
+		list   []*history.Post
+		output string
+		err    error
+	}{
+		{format: history.FormatJSON, list: nil, output: "[]\n", err: nil},
+		{format: history.FormatJSON, list: list, output: `[{"id":1,"posted_at":"2026-10-17T12:00:00Z","destination":"Bluesky","uri":"at://foo/1","source_type":"apod","source":"2026-10-17","content_hash":"abc","text":"hello, \"world\""}]` + "\n", err: nil},
+		{format: history.FormatCSV, list: list, output: "id,posted_at,destination,uri,source_type,source,content_hash,text\n1,2026-10-17T12:00:00Z,Bluesky,at://foo/1,apod,2026-10-17,abc,\"hello, \"\"world\"\"\"\n", err: nil},
+		{format: history.FormatUnknown, list: list, output: "", err: ecode.ErrInvalidFormat},
+	}
+	for _, tc := range testCases {
+		buf := &bytes.Buffer{}
+		err := history.Encode(buf, tc.list, tc.format)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Encode() error = \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if buf.String() != tc.output {
+			t.Errorf("Encode() = \"%v\", want \"%v\".", buf.String(), tc.output)
+		}
+	}
+}
+
+func TestFormatFrom(t *testing.T) {
+	testCases := []struct {
+		s      string
+		format history.Format
+		err    error
+	}{
+		{s: "table", format: history.FormatTable, err: nil},
+		{s: "JSON", format: history.FormatJSON, err: nil},
+		{s: "csv", format: history.FormatCSV, err: nil},
+		{s: "xml", format: history.FormatUnknown, err: ecode.ErrInvalidFormat},
+	}
+	for _, tc := range testCases {
+		format, err := history.FormatFrom(tc.s)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FormatFrom() error = \"%+v\", want \"%+v\".", err, tc.err)
+		}
+		if format != tc.format {
+			t.Errorf("FormatFrom() = \"%v\", want \"%v\".", format, tc.format)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/goark/errs"
@@ -23,18 +24,36 @@ func New(lvl Level, name, confDir, logDir string) (*log.ZapEventLogger, error) {
 	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("level", lvl.String()))
 	}
+	setupLogging(name, level, getPath(logDir))
+
+	logger := log.Logger(name)
+	logger.SugaredLogger = *(logger.Desugar().WithOptions(zap.WithCaller(false)).Sugar())
+	return logger, nil
+}
+
+var (
+	setupMutex sync.Mutex
+	setupKey   string // level and log file of last setup
+)
+
+// setupLogging function sets up logging to file. If logging is already set up with the same level and file,
+// it does nothing (log file is not opened again in long-running process, e.g. serve command).
+func setupLogging(name string, level log.LogLevel, path string) {
+	setupMutex.Lock()
+	defer setupMutex.Unlock()
+	key := fmt.Sprintf("%d:%s", level, path)
+	if key == setupKey {
+		return
+	}
 	cfg := log.GetConfig()
 	cfg.Format = log.JSONOutput
 	cfg.Stderr = false
 	cfg.Stdout = false
 	delete(cfg.Labels, name)
 	cfg.Level = level
-	cfg.File = getPath(logDir)
+	cfg.File = path
 	log.SetupLogging(cfg)
-
-	logger := log.Logger(name)
-	logger.SugaredLogger = *(logger.Desugar().WithOptions(zap.WithCaller(false)).Sugar())
-	return logger, nil
+	setupKey = key
 }
 
 // Nop function returns nop logger
@@ -0,0 +1,85 @@
+package mastodon
+
+import (
+	"context"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+	mstdn "github.com/mattn/go-mastodon"
+	"go.uber.org/zap"
+)
+
+// DeleteStatus method deletes status by status URL or ID.
+func (cfg *Mastodon) DeleteStatus(ctx context.Context, status string) error {
+	if cfg == nil || cfg.client == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	id := StatusID(status)
+	if len(id) == 0 {
+		return errs.Wrap(ecode.ErrNoContent, errs.WithContext("status", status))
+	}
+	cfg.Logger().Debug("start deleting status", zap.String("id", string(id)))
+	if err := cfg.client.DeleteStatus(ctx, id); err != nil {
+		return errs.Wrap(err, errs.WithContext("status", status), errs.WithContext("id", id))
+	}
+	cfg.Logger().Info("complete deleting status", zap.String("id", string(id)))
+	return nil
+}
+
+// EditStatus method edits text (and spoiler text) of status by status URL or ID, and returns URL of the status.
+// Media attachments, sensitive flag and spoiler text (if not given) of the status are kept.
+func (cfg *Mastodon) EditStatus(ctx context.Context, status string, msg *Message) (string, error) {
+	if cfg == nil || cfg.client == nil {
+		return "", errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(msg.Msg) == 0 {
+		return "", errs.Wrap(ecode.ErrNoContent, errs.WithContext("status", status))
+	}
+	id := StatusID(status)
+	if len(id) == 0 {
+		return "", errs.Wrap(ecode.ErrNoContent, errs.WithContext("status", status))
+	}
+
+	// get current status
+	current, err := cfg.client.GetStatus(ctx, id)
+	if err != nil {
+		return "", errs.Wrap(err, errs.WithContext("status", status), errs.WithContext("id", id))
+	}
+	// spoiler text is replaced by edit, so keep current one if not given
+	spoilerText := msg.SpoilerText
+	if len(spoilerText) == 0 {
+		spoilerText = current.SpoilerText
+	}
+	toot := &mstdn.Toot{
+		Status:      msg.Msg,
+		SpoilerText: spoilerText,
+		Sensitive:   current.Sensitive || len(spoilerText) > 0,
+	}
+	for _, attch := range current.MediaAttachments {
+		toot.MediaIDs = append(toot.MediaIDs, attch.ID)
+	}
+
+	// update status
+	cfg.Logger().Debug("start editing status", zap.String("id", string(id)), zap.Any("toot", toot))
+	stat, err := cfg.client.UpdateStatus(ctx, toot, id)
+	if err != nil {
+		return "", errs.Wrap(err, errs.WithContext("status", status), errs.WithContext("id", id))
+	}
+	cfg.Logger().Info("complete editing status", zap.Any("response_of_edit", stat))
+	return stat.URL, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,134 @@
+package mastodon_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goark/toolbox/mastodon"
+)
+
+func newTestMastodon(t *testing.T, handler http.HandlerFunc) *mastodon.Mastodon {
+	t.Helper()
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+	path := filepath.Join(t.TempDir(), "mastodon.json")
+	if err := os.WriteFile(path, []byte(fmt.Sprintf(`{"server":%q,"access_token":"token"}`, ts.URL)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := mastodon.New(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+func TestEditStatus(t *testing.T) {
+	var form map[string][]string
+	cfg := newTestMastodon(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/statuses/123":
+			fmt.Fprint(w, `{"id":"123","sensitive":true,"media_attachments":[{"id":"m1"},{"id":"m2"}]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/statuses/123":
+			_ = r.ParseForm()
+			form = r.PostForm
+			fmt.Fprint(w, `{"id":"123","url":"https://example.com/@foo/123"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	url, err := cfg.EditStatus(context.Background(), "https://example.com/@foo/123", &mastodon.Message{Msg: "fixed text"})
+	if err != nil {
+		t.Fatalf("EditStatus() error = \"%+v\", want nil.", err)
+	}
+	if url != "https://example.com/@foo/123" {
+		t.Errorf("EditStatus() = \"%v\", want \"%v\".", url, "https://example.com/@foo/123")
+	}
+	if got := form["status"]; len(got) != 1 || got[0] != "fixed text" {
+		t.Errorf("EditStatus() status = %v, want %v.", got, "fixed text")
+	}
+	if got := form["media_ids[]"]; len(got) != 2 || got[0] != "m1" || got[1] != "m2" {
+		t.Errorf("EditStatus() media_ids = %v, want %v.", got, []string{"m1", "m2"})
+	}
+	if got := form["sensitive"]; len(got) != 1 || got[0] != "true" {
+		t.Errorf("EditStatus() sensitive = %v, want %v.", got, "true")
+	}
+}
+
+func TestEditStatusKeepSpoilerText(t *testing.T) {
+	var form map[string][]string
+	cfg := newTestMastodon(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/statuses/123":
+			fmt.Fprint(w, `{"id":"123","sensitive":true,"spoiler_text":"current CW"}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/api/v1/statuses/123":
+			_ = r.ParseForm()
+			form = r.PostForm
+			fmt.Fprint(w, `{"id":"123","url":"https://example.com/@foo/123"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	testCases := []struct {
+		spoilerText string
+		want        string
+	}{
+		{spoilerText: "", want: "current CW"},
+		{spoilerText: "new CW", want: "new CW"},
+	}
+	for _, tc := range testCases {
+		if _, err := cfg.EditStatus(context.Background(), "123", &mastodon.Message{Msg: "fixed text", SpoilerText: tc.spoilerText}); err != nil {
+			t.Errorf("EditStatus() error = \"%+v\", want nil.", err)
+			continue
+		}
+		if got := form["spoiler_text"]; len(got) != 1 || got[0] != tc.want {
+			t.Errorf("EditStatus() spoiler_text = %v, want %v.", got, tc.want)
+		}
+	}
+}
+
+func TestDeleteStatus(t *testing.T) {
+	var deleted string
+	cfg := newTestMastodon(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			deleted = r.URL.Path
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		http.NotFound(w, r)
+	})
+	testCases := []struct {
+		status string
+		path   string
+	}{
+		{status: "123", path: "/api/v1/statuses/123"},
+		{status: "https://example.com/@foo/456", path: "/api/v1/statuses/456"},
+	}
+	for _, tc := range testCases {
+		if err := cfg.DeleteStatus(context.Background(), tc.status); err != nil {
+			t.Errorf("DeleteStatus() error = \"%+v\", want nil.", err)
+		}
+		if deleted != tc.path {
+			t.Errorf("DeleteStatus() requests \"%v\", want \"%v\".", deleted, tc.path)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,111 @@
+package mastodon
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultMaxCharacters is default maximum number of characters in a status.
+	DefaultMaxCharacters = 500
+	// DefaultCharactersReservedPerURL is default number of characters counted for each URL.
+	DefaultCharactersReservedPerURL = 23
+)
+
+// InstanceLimits is limits of status in Mastodon instance.
+type InstanceLimits struct {
+	MaxCharacters            int
+	CharactersReservedPerURL int
+}
+
+// Limits method returns limits of status in the instance. If cannot get instance configuration, returns default limits.
+func (cfg *Mastodon) Limits(ctx context.Context) *InstanceLimits {
+	limits := &InstanceLimits{
+		MaxCharacters:            DefaultMaxCharacters,
+		CharactersReservedPerURL: DefaultCharactersReservedPerURL,
+	}
+	if cfg == nil {
+		return limits
+	}
+	if cfg.limits != nil {
+		return cfg.limits
+	}
+	if err := cfg.readLimits(ctx, limits); err != nil {
+		cfg.Logger().Info("cannot get instance configuration, use default limits", zap.Object("error", zapobject.New(err)), zap.Any("limits", limits))
+	}
+	cfg.limits = limits
+	return limits
+}
+
+func (cfg *Mastodon) readLimits(ctx context.Context, limits *InstanceLimits) error {
+	if cfg.client == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	inst, err := cfg.client.GetInstance(ctx)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("server", cfg.Server))
+	}
+	if conf := inst.GetConfig(); conf != nil && conf.Statuses != nil {
+		statuses := *conf.Statuses
+		if n, ok := statuses["max_characters"]; ok && n > 0 {
+			limits.MaxCharacters = n
+		}
+		if n, ok := statuses["characters_reserved_per_url"]; ok && n > 0 {
+			limits.CharactersReservedPerURL = n
+		}
+	}
+	cfg.Logger().Debug("instance limits", zap.String("server", cfg.Server), zap.Any("limits", limits))
+	return nil
+}
+
+var (
+	urlRegexp     = regexp.MustCompile(`https?://[-A-Za-z0-9+&@#\/%?=~_|!:,.;\(\)]+`)
+	mentionRegexp = regexp.MustCompile(`@([a-zA-Z0-9_]+)@[a-zA-Z0-9.\-]+[a-zA-Z0-9]`)
+	tagRegexp     = regexp.MustCompile(`\B#[^\s#]+`)
+)
+
+// Length method returns length of text counted by Mastodon rule.
+// Each URL is counted as fixed number of characters, and domain part of mention is not counted.
+func (limits *InstanceLimits) Length(text string) int {
+	n := poster.GraphemeCount(text)
+	for _, m := range urlRegexp.FindAllString(text, -1) {
+		n += limits.CharactersReservedPerURL - poster.GraphemeCount(m)
+	}
+	for _, m := range mentionRegexp.FindAllStringSubmatch(text, -1) {
+		n -= poster.GraphemeCount(m[0]) - poster.GraphemeCount(m[1]) - 1
+	}
+	return n
+}
+
+// SplitText method splits text into chunks within maximum number of characters.
+// Links, mentions and tags are never split.
+func (limits *InstanceLimits) SplitText(text string) []string {
+	protected := []poster.Span{}
+	for _, re := range []*regexp.Regexp{urlRegexp, mentionRegexp, tagRegexp} {
+		for _, m := range re.FindAllStringIndex(text, -1) {
+			protected = append(protected, poster.Span{Start: m[0], End: m[1]})
+		}
+	}
+	return poster.SplitText(text, limits.MaxCharacters, limits.Length, protected)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,57 @@
+package mastodon_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/mastodon"
+)
+
+func TestLength(t *testing.T) {
+	limits := &mastodon.InstanceLimits{MaxCharacters: 500, CharactersReservedPerURL: 23}
+	testCases := []struct {
+		text   string
+		length int
+	}{
+		{text: "", length: 0},
+		{text: "hello", length: 5},
+		{text: "こんにちは", length: 5},
+		{text: "see https://example.com/a/very/long/path/to/the/page", length: 4 + 23},
+		{text: "hi @spiegel@goark.social", length: 3 + 8},
+	}
+	for _, tc := range testCases {
+		if n := limits.Length(tc.text); n != tc.length {
+			t.Errorf("InstanceLimits.Length(%q) = %v, want %v.", tc.text, n, tc.length)
+		}
+	}
+}
+
+func TestSplitText(t *testing.T) {
+	limits := &mastodon.InstanceLimits{MaxCharacters: 30, CharactersReservedPerURL: 23}
+	text := "first line of text\nhttps://example.com/a/very/long/path/to/the/page #tag\nlast line"
+	want := []string{"first line of text", "https://example.com/a/very/long/path/to/the/page #tag", "last line"}
+	chunks := limits.SplitText(text)
+	if strings.Join(chunks, "|") != strings.Join(want, "|") {
+		t.Errorf("InstanceLimits.SplitText() = %q, want %q.", chunks, want)
+	}
+	for _, c := range chunks {
+		if n := limits.Length(c); n > limits.MaxCharacters {
+			t.Errorf("InstanceLimits.SplitText() chunk %q has length %d, want <= %d.", c, n, limits.MaxCharacters)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
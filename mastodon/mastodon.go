@@ -25,6 +25,7 @@ type Mastodon struct {
 	ClientSecret string `json:"client_secret"`
 	AccessToken  string `json:"access_token"`
 	client       *mstdn.Client
+	limits       *InstanceLimits
 	logger       *log.ZapEventLogger
 }
 
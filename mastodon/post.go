@@ -2,11 +2,16 @@ package mastodon
 
 import (
 	"context"
+	"net/url"
+	"os"
+	"path"
 	"strings"
 
 	"github.com/goark/errs"
 	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
 	mstdn "github.com/mattn/go-mastodon"
+	"github.com/rivo/uniseg"
 	"go.uber.org/zap"
 )
 
@@ -70,50 +75,115 @@ type Message struct {
 	Msg         string
 	SpoilerText string
 	Visibility  string
+	ReplyTo     string
 	ImageFiles  []string
+	ImageAlts   []string
+	Thread      bool
+	Posted      []string // URLs of statuses already posted in thread (resume posting from next chunk)
 }
 
 // PostMessage method posts message and image files to Mastodon.
+// If Thread flag is true and message is too long for the instance, posts the message as reply chain, and returns URL of the first status.
 func (cfg *Mastodon) PostMessage(ctx context.Context, msg *Message) (string, error) {
 	if cfg == nil || cfg.client == nil {
 		return "", errs.Wrap(ecode.ErrNullPointer)
 	}
 
-	// upload images
-	images, err := cfg.uploadImages(ctx, msg.ImageFiles)
-	if err != nil {
-		return "", errs.Wrap(err)
+	// split message (spoiler text is counted in each status)
+	chunks := []string{msg.Msg}
+	if msg.Thread {
+		limits := *cfg.Limits(ctx)
+		limits.MaxCharacters -= poster.GraphemeCount(msg.SpoilerText)
+		if limits.MaxCharacters <= 0 {
+			return "", errs.Wrap(ecode.ErrTooLongSpoilerText, errs.WithContext("spoiler_text", msg.SpoilerText))
+		}
+		if limits.Length(msg.Msg) > limits.MaxCharacters {
+			chunks = limits.SplitText(msg.Msg)
+			cfg.Logger().Debug("split message", zap.Int("chunks", len(chunks)), zap.Int("max_characters", limits.MaxCharacters))
+		}
+	}
+	if len(chunks) == 0 {
+		return "", errs.Wrap(ecode.ErrNoContent)
 	}
 
-	// make toot
-	toot := &mstdn.Toot{
-		Status:      msg.Msg,
-		Visibility:  msg.Visibility,
-		SpoilerText: msg.SpoilerText,
-		MediaIDs:    images,
+	// resume thread (next status replies to the last posted status)
+	urls := append([]string{}, msg.Posted...)
+	if len(urls) >= len(chunks) {
+		return urls[0], nil
 	}
-	if len(toot.SpoilerText) > 0 {
-		toot.Sensitive = true
+	replyTo := StatusID(msg.ReplyTo)
+	if len(urls) > 0 {
+		replyTo = StatusID(urls[len(urls)-1])
+		cfg.Logger().Info("resume posting thread", zap.Strings("posted", urls))
 	}
 
-	// post toot
-	cfg.Logger().Debug("start posting message", zap.Any("toot", toot))
-	stat, err := cfg.client.PostStatus(ctx, toot)
-	if err != nil {
-		return "", errs.Wrap(err)
+	// upload images (first status only)
+	var images []mstdn.ID
+	if len(urls) == 0 {
+		var err error
+		if images, err = cfg.uploadImages(ctx, msg.ImageFiles, msg.ImageAlts); err != nil {
+			return "", errs.Wrap(err)
+		}
+	}
+
+	for i := len(urls); i < len(chunks); i++ {
+		// make toot
+		toot := &mstdn.Toot{
+			Status:      chunks[i],
+			Visibility:  msg.Visibility,
+			SpoilerText: msg.SpoilerText,
+			InReplyToID: replyTo,
+		}
+		if i == 0 {
+			toot.MediaIDs = images
+		}
+		if len(toot.SpoilerText) > 0 {
+			toot.Sensitive = true
+		}
+
+		// post toot
+		cfg.Logger().Debug("start posting message", zap.Any("toot", toot))
+		stat, err := cfg.client.PostStatus(ctx, toot)
+		if err != nil {
+			return "", errs.Wrap(&poster.ThreadError{Posted: urls, Err: err}, errs.WithContext("chunk", i))
+		}
+		cfg.Logger().Info("complete posting message", zap.Any("response_of_post", stat))
+		urls = append(urls, stat.URL)
+		replyTo = stat.ID
+	}
+	if len(urls) > 1 {
+		cfg.Logger().Info("complete posting thread", zap.Strings("urls", urls))
+	}
+	return urls[0], nil
+}
+
+// StatusID function returns status ID from status URL or ID string.
+func StatusID(s string) mstdn.ID {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return ""
+	}
+	if u, err := url.Parse(s); err == nil && len(u.Host) > 0 {
+		s = path.Base(strings.TrimSuffix(u.Path, "/"))
 	}
-	cfg.Logger().Info("complete posting message", zap.Any("response_of_post", stat))
-	return stat.URL, nil
+	return mstdn.ID(s)
 }
 
-func (cfg *Mastodon) uploadImages(ctx context.Context, paths []string) ([]mstdn.ID, error) {
+// MaxDescriptionCharacters is maximum number of characters in description (alt text) of media.
+const MaxDescriptionCharacters = 1500
+
+func (cfg *Mastodon) uploadImages(ctx context.Context, paths, alts []string) ([]mstdn.ID, error) {
 	if len(paths) == 0 {
 		return nil, nil
 	}
 	list := make([]mstdn.ID, 0, len(paths))
-	for _, path := range paths {
-		cfg.Logger().Debug("start uploading image file", zap.String("path", path))
-		attch, err := cfg.client.UploadMedia(ctx, path)
+	for i, path := range paths {
+		var alt string
+		if i < len(alts) {
+			alt = truncate(alts[i], MaxDescriptionCharacters)
+		}
+		cfg.Logger().Debug("start uploading image file", zap.String("path", path), zap.String("description", alt))
+		attch, err := cfg.uploadMedia(ctx, path, alt)
 		if err != nil {
 			return nil, errs.Wrap(err, errs.WithContext("path", path))
 		}
@@ -123,6 +193,32 @@ func (cfg *Mastodon) uploadImages(ctx context.Context, paths []string) ([]mstdn.
 	return list, nil
 }
 
+func (cfg *Mastodon) uploadMedia(ctx context.Context, path, description string) (*mstdn.Attachment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	defer file.Close()
+	attch, err := cfg.client.UploadMediaFromMedia(ctx, &mstdn.Media{File: file, Description: description})
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return attch, nil
+}
+
+// truncate function truncates text within max graphemes.
+func truncate(text string, max int) string {
+	if poster.GraphemeCount(text) <= max {
+		return text
+	}
+	gr := uniseg.NewGraphemes(text)
+	bld := strings.Builder{}
+	for i := 0; i < max-1 && gr.Next(); i++ {
+		bld.WriteString(gr.Str())
+	}
+	return bld.String() + "…"
+}
+
 /* Copyright 2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,119 @@
+package mastodon_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/mastodon"
+	"github.com/goark/toolbox/poster"
+)
+
+func TestPostMessageThread(t *testing.T) {
+	limits := &mastodon.InstanceLimits{MaxCharacters: 30, CharactersReservedPerURL: 23}
+	var posted []string
+	cfg := newTestMastodon(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/instance":
+			fmt.Fprintf(w, `{"uri":"example.com","configuration":{"statuses":{"max_characters":%d,"characters_reserved_per_url":%d}}}`, limits.MaxCharacters, limits.CharactersReservedPerURL)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/statuses":
+			_ = r.ParseForm()
+			if spoiler := r.PostForm.Get("spoiler_text"); limits.Length(spoiler)+limits.Length(r.PostForm.Get("status")) > limits.MaxCharacters {
+				http.Error(w, `{"error":"too long"}`, http.StatusUnprocessableEntity)
+				return
+			}
+			posted = append(posted, r.PostForm.Get("status"))
+			fmt.Fprintf(w, `{"id":"%d","url":"https://example.com/@foo/%d"}`, len(posted), len(posted))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	testCases := []struct {
+		msg    *mastodon.Message
+		chunks int
+		err    error
+	}{
+		{msg: &mastodon.Message{Msg: "first line of text\nsecond line of text", SpoilerText: "spoiler", Thread: true}, chunks: 2},
+		{msg: &mastodon.Message{Msg: "first line of text\nsecond", SpoilerText: "spoiler", Thread: true}, chunks: 2}, // too long with spoiler text
+		{msg: &mastodon.Message{Msg: "short text", SpoilerText: "spoiler", Thread: true}, chunks: 1},
+		{msg: &mastodon.Message{Msg: strings.Repeat(" ", 40), Thread: true}, err: ecode.ErrNoContent},
+		{msg: &mastodon.Message{Msg: "text", SpoilerText: strings.Repeat("x", 30), Thread: true}, err: ecode.ErrTooLongSpoilerText},
+	}
+	for _, tc := range testCases {
+		posted = nil
+		url, err := cfg.PostMessage(context.Background(), tc.msg)
+		if tc.err != nil {
+			if !errors.Is(err, tc.err) {
+				t.Errorf("PostMessage(%q) error = \"%+v\", want \"%+v\".", tc.msg.Msg, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("PostMessage(%q) error = \"%+v\", want nil.", tc.msg.Msg, err)
+			continue
+		}
+		if url != "https://example.com/@foo/1" || len(posted) != tc.chunks {
+			t.Errorf("PostMessage(%q) = %v (%q posted), want %v chunks.", tc.msg.Msg, url, posted, tc.chunks)
+		}
+	}
+}
+
+func TestPostMessageResumeThread(t *testing.T) {
+	var posted, replyTo []string
+	failed := false
+	cfg := newTestMastodon(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/instance":
+			fmt.Fprint(w, `{"uri":"example.com","configuration":{"statuses":{"max_characters":30,"characters_reserved_per_url":23}}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/statuses":
+			_ = r.ParseForm()
+			if len(posted) == 1 && !failed {
+				failed = true
+				http.Error(w, `{"error":"temporary error"}`, http.StatusServiceUnavailable)
+				return
+			}
+			posted = append(posted, r.PostForm.Get("status"))
+			replyTo = append(replyTo, r.PostForm.Get("in_reply_to_id"))
+			fmt.Fprintf(w, `{"id":"%d","url":"https://example.com/@foo/%d"}`, len(posted), len(posted))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	msg := &mastodon.Message{Msg: "first line of text\nsecond line of text", Thread: true}
+	_, err := cfg.PostMessage(context.Background(), msg)
+	if err == nil {
+		t.Fatal("PostMessage() error = nil, want error.")
+	}
+	msg.Posted = poster.PostedChunks(err)
+	if strings.Join(msg.Posted, " ") != "https://example.com/@foo/1" {
+		t.Fatalf("PostedChunks() = %v, want first status.", msg.Posted)
+	}
+	url, err := cfg.PostMessage(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("PostMessage() error = \"%+v\", want nil.", err)
+	}
+	if url != "https://example.com/@foo/1" || len(posted) != 2 || replyTo[1] != "1" {
+		t.Errorf("PostMessage() = %v (%q posted in reply to %q), want second chunk in reply to first status.", url, posted, replyTo)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,48 @@
+package mastodon
+
+import (
+	"context"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/poster"
+)
+
+var _ poster.Poster = (*Mastodon)(nil)
+
+// Name method returns name of destination (poster.Poster interface).
+func (cfg *Mastodon) Name() string {
+	return "Mastodon"
+}
+
+// Post method posts neutral message to Mastodon (poster.Poster interface).
+// Long message is posted as reply chain, and posting is resumed after posted statuses in msg.Posted.
+func (cfg *Mastodon) Post(ctx context.Context, msg *poster.Message) (string, error) {
+	resText, err := cfg.PostMessage(ctx, &Message{
+		Msg:        msg.Text,
+		ReplyTo:    msg.ReplyTo,
+		ImageFiles: msg.ImageFiles(),
+		ImageAlts:  msg.ImageAlts(),
+		Visibility: msg.Visibility,
+		Thread:     true,
+		Posted:     msg.Posted,
+	})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return resText, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
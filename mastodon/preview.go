@@ -0,0 +1,116 @@
+package mastodon
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
+	mstdn "github.com/mattn/go-mastodon"
+)
+
+var _ poster.Previewer = (*Mastodon)(nil)
+
+// Preview method makes final payloads of neutral message without posting (poster.Previewer interface).
+func (cfg *Mastodon) Preview(ctx context.Context, msg *poster.Message) (*poster.Preview, error) {
+	posts, err := cfg.PreviewMessage(ctx, &Message{
+		Msg:        msg.Text,
+		ReplyTo:    msg.ReplyTo,
+		ImageFiles: msg.ImageFiles(),
+		ImageAlts:  msg.ImageAlts(),
+		Visibility: msg.Visibility,
+		Thread:     true,
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &poster.Preview{Name: cfg.Name(), Posts: posts}, nil
+}
+
+// PreviewMessage method makes toots of message without uploading image files and posting.
+// Image files are sent to Mastodon as is, so size of image is size of file.
+func (cfg *Mastodon) PreviewMessage(ctx context.Context, msg *Message) ([]*poster.PreviewPost, error) {
+	if cfg == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(msg.Msg) == 0 {
+		return nil, errs.Wrap(ecode.ErrNoContent)
+	}
+
+	limits := cfg.Limits(ctx)
+	chunks := []string{msg.Msg}
+	if msg.Thread && limits.Length(msg.Msg) > limits.MaxCharacters {
+		chunks = limits.SplitText(msg.Msg)
+	}
+
+	// image files
+	var imgs []*poster.PreviewImage
+	for i, path := range msg.ImageFiles {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("path", path))
+		}
+		var alt string
+		if i < len(msg.ImageAlts) {
+			alt = truncate(msg.ImageAlts[i], MaxDescriptionCharacters)
+		}
+		imgs = append(imgs, &poster.PreviewImage{
+			Path:         path,
+			Alt:          alt,
+			OriginalSize: int(info.Size()),
+			Size:         int(info.Size()),
+		})
+	}
+
+	posts := make([]*poster.PreviewPost, 0, len(chunks))
+	replyTo := StatusID(msg.ReplyTo)
+	for i, chunk := range chunks {
+		toot := &mstdn.Toot{
+			Status:      chunk,
+			Visibility:  msg.Visibility,
+			SpoilerText: msg.SpoilerText,
+			InReplyToID: replyTo,
+		}
+		pp := &poster.PreviewPost{
+			Text:      chunk,
+			Length:    limits.Length(chunk),
+			MaxLength: limits.MaxCharacters,
+			Payload:   toot,
+		}
+		if i == 0 {
+			pp.Images = imgs
+			for j := range imgs {
+				// media IDs are unknown until uploading
+				toot.MediaIDs = append(toot.MediaIDs, mstdn.ID(fmt.Sprintf("(media %d)", j+1)))
+			}
+		}
+		if len(toot.SpoilerText) > 0 {
+			toot.Sensitive = true
+			pp.Details = append(pp.Details, "Spoiler: "+toot.SpoilerText)
+		}
+		if len(toot.Visibility) > 0 {
+			pp.Details = append(pp.Details, "Visibility: "+toot.Visibility)
+		}
+		posts = append(posts, pp)
+		// status ID is unknown until posting
+		replyTo = mstdn.ID(fmt.Sprintf("(status %d)", i+1))
+	}
+	return posts, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,198 @@
+package poster
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/logger"
+	"github.com/ipfs/go-log/v2"
+	"go.uber.org/zap"
+)
+
+// Result is result of posting message to a destination.
+// If the same content was already posted, Skipped is true and URI is URI of the previous post.
+type Result struct {
+	Name    string
+	URI     string
+	Skipped bool
+	Err     error
+}
+
+// Dispatcher is fan-out engine for posting message to multiple destinations.
+type Dispatcher struct {
+	posters []Poster
+	history History
+	force   bool
+	logger  *log.ZapEventLogger
+}
+
+// NewDispatcher function creates new Dispatcher instance.
+func NewDispatcher(logger *log.ZapEventLogger, posters ...Poster) *Dispatcher {
+	d := &Dispatcher{logger: logger}
+	for _, p := range posters {
+		d.Add(p)
+	}
+	return d
+}
+
+// Add method adds Poster to destinations.
+func (d *Dispatcher) Add(p Poster) {
+	if d == nil || p == nil {
+		return
+	}
+	d.posters = append(d.posters, p)
+}
+
+// SetHistory method sets History for recording posts and preventing duplicate posts.
+// If force flag is true, posts message even if the same content was already posted.
+func (d *Dispatcher) SetHistory(h History, force bool) {
+	if d == nil {
+		return
+	}
+	d.history = h
+	d.force = force
+}
+
+// Len method returns count of destinations.
+func (d *Dispatcher) Len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.posters)
+}
+
+// Names method returns list of destination names.
+func (d *Dispatcher) Names() []string {
+	if d == nil {
+		return nil
+	}
+	names := make([]string, 0, len(d.posters))
+	for _, p := range d.posters {
+		names = append(names, p.Name())
+	}
+	return names
+}
+
+// Select method returns new Dispatcher instance with destinations matching names (case-insensitive).
+// Settings of post history are inherited.
+func (d *Dispatcher) Select(names ...string) *Dispatcher {
+	if d == nil {
+		return nil
+	}
+	selected := &Dispatcher{history: d.history, force: d.force, logger: d.logger}
+	for _, p := range d.posters {
+		for _, name := range names {
+			if strings.EqualFold(p.Name(), name) {
+				selected.posters = append(selected.posters, p)
+				break
+			}
+		}
+	}
+	return selected
+}
+
+// Logger method returns zap.Logger instance.
+func (d *Dispatcher) Logger() *zap.Logger {
+	if d == nil || d.logger == nil {
+		return logger.Nop().Desugar()
+	}
+	return d.logger.Desugar()
+}
+
+// Post method posts message to all destinations, and returns results per destination.
+// If History is set, skips destinations which the same content was already posted to (except force flag), and records posts.
+func (d *Dispatcher) Post(ctx context.Context, msg *Message) []*Result {
+	if d == nil {
+		return nil
+	}
+	results := make([]*Result, 0, len(d.posters))
+	for _, p := range d.posters {
+		res := d.post(ctx, p, msg)
+		switch {
+		case res.Err != nil:
+			d.Logger().Error("error in posting message", zap.String("destination", res.Name), zap.Object("error", zapobject.New(res.Err)))
+		case res.Skipped:
+			d.Logger().Info("skip posting message (already posted)", zap.String("destination", res.Name), zap.String("uri", res.URI))
+		default:
+			d.Logger().Info("complete posting message", zap.String("destination", res.Name), zap.String("uri", res.URI))
+		}
+		results = append(results, res)
+	}
+	return results
+}
+
+func (d *Dispatcher) post(ctx context.Context, p Poster, msg *Message) *Result {
+	res := &Result{Name: p.Name()}
+	if msg == nil {
+		res.Err = errs.Wrap(ecode.ErrNullPointer, errs.WithContext("destination", res.Name))
+		return res
+	}
+	hash := msg.ContentHash()
+	if d.history != nil && !d.force {
+		rec, err := d.history.FindPosted(ctx, res.Name, hash)
+		if err != nil {
+			res.Err = errs.Wrap(err, errs.WithContext("destination", res.Name))
+			return res
+		}
+		if rec != nil {
+			res.URI = rec.URI
+			res.Skipped = true
+			return res
+		}
+	}
+	uri, err := p.Post(ctx, msg)
+	if err != nil {
+		res.Err = errs.Wrap(err, errs.WithContext("destination", res.Name))
+		return res
+	}
+	res.URI = uri
+	if d.history != nil {
+		if err := d.history.AddPosted(ctx, &Record{
+			Destination: res.Name,
+			URI:         uri,
+			SourceType:  msg.Source.Type,
+			Source:      msg.Source.ID,
+			ContentHash: hash,
+			Text:        msg.Text,
+			PostedAt:    time.Now(),
+		}); err != nil {
+			// message was already posted, so only logging error
+			d.Logger().Error("error in recording post history", zap.String("destination", res.Name), zap.Object("error", zapobject.New(errs.Wrap(err))))
+		}
+	}
+	return res
+}
+
+// Errors function returns joined error in results.
+func Errors(results []*Result) error {
+	var list []error
+	for _, res := range results {
+		if res != nil && res.Err != nil {
+			list = append(list, res.Err)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	return errors.Join(list...)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,255 @@
+package poster_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/goark/toolbox/poster"
+)
+
+type testPoster struct {
+	name string
+	uri  string
+	err  error
+	msgs []*poster.Message
+}
+
+func (p *testPoster) Name() string { return p.name }
+
+func (p *testPoster) Post(_ context.Context, msg *poster.Message) (string, error) {
+	p.msgs = append(p.msgs, msg)
+	if p.err != nil {
+		return "", p.err
+	}
+	return p.uri, nil
+}
+
+func TestDispatcherPost(t *testing.T) {
+	errTest := errors.New("test error")
+	p1 := &testPoster{name: "foo", uri: "https://example.com/foo/1"}
+	p2 := &testPoster{name: "bar", err: errTest}
+	d := poster.NewDispatcher(nil, p1, p2)
+	if d.Len() != 2 {
+		t.Errorf("Dispatcher.Len() = %v, want %v.", d.Len(), 2)
+	}
+	msg := &poster.Message{Text: "hello", Images: []*poster.Image{{Path: "image.jpg", Alt: "image"}}}
+	results := d.Post(context.Background(), msg)
+	if len(results) != 2 {
+		t.Fatalf("Dispatcher.Post() returns %v results, want %v.", len(results), 2)
+	}
+	if results[0].Name != "foo" || results[0].URI != p1.uri || results[0].Err != nil {
+		t.Errorf("Dispatcher.Post()[0] = %+v, want name %q and uri %q.", results[0], "foo", p1.uri)
+	}
+	if results[1].Name != "bar" || !errors.Is(results[1].Err, errTest) {
+		t.Errorf("Dispatcher.Post()[1] = %+v, want error %v.", results[1], errTest)
+	}
+	if len(p1.msgs) != 1 || p1.msgs[0] != msg || len(p2.msgs) != 1 || p2.msgs[0] != msg {
+		t.Errorf("Dispatcher.Post() does not pass the same message to all destinations.")
+	}
+	if err := poster.Errors(results); !errors.Is(err, errTest) {
+		t.Errorf("Errors() = %v, want %v.", err, errTest)
+	}
+}
+
+func TestDispatcherSelect(t *testing.T) {
+	p1 := &testPoster{name: "Bluesky"}
+	p2 := &testPoster{name: "Mastodon"}
+	d := poster.NewDispatcher(nil, p1, p2)
+	testCases := []struct {
+		names []string
+		want  []string
+	}{
+		{names: nil, want: []string{}},
+		{names: []string{"mastodon"}, want: []string{"Mastodon"}},
+		{names: []string{"MASTODON", "bluesky", "unknown"}, want: []string{"Bluesky", "Mastodon"}},
+	}
+	for _, tc := range testCases {
+		names := d.Select(tc.names...).Names()
+		if len(names) != len(tc.want) {
+			t.Errorf("Dispatcher.Select(%v) = %v, want %v.", tc.names, names, tc.want)
+			continue
+		}
+		for i := range names {
+			if names[i] != tc.want[i] {
+				t.Errorf("Dispatcher.Select(%v) = %v, want %v.", tc.names, names, tc.want)
+				break
+			}
+		}
+	}
+}
+
+type testHistory struct {
+	records []*poster.Record
+}
+
+func (h *testHistory) FindPosted(_ context.Context, destination, contentHash string) (*poster.Record, error) {
+	for _, rec := range h.records {
+		if rec.Destination == destination && rec.ContentHash == contentHash {
+			return rec, nil
+		}
+	}
+	return nil, nil
+}
+
+func (h *testHistory) AddPosted(_ context.Context, rec *poster.Record) error {
+	h.records = append(h.records, rec)
+	return nil
+}
+
+func TestDispatcherPostWithHistory(t *testing.T) {
+	testCases := []struct {
+		force   bool
+		skipped bool
+		posts   int
+	}{
+		{force: false, skipped: true, posts: 1},
+		{force: true, skipped: false, posts: 2},
+	}
+	for _, tc := range testCases {
+		p := &testPoster{name: "foo", uri: "https://example.com/foo/1"}
+		h := &testHistory{}
+		d := poster.NewDispatcher(nil, p)
+		d.SetHistory(h, tc.force)
+		msg := &poster.Message{Text: "hello", Source: poster.Source{Type: poster.SourceWebpage, ID: "https://example.com/"}}
+		if results := d.Post(context.Background(), msg); len(results) != 1 || results[0].Skipped {
+			t.Errorf("Dispatcher.Post() = %+v, want not skipped.", results[0])
+		}
+		if len(h.records) != 1 || h.records[0].Source != "https://example.com/" || h.records[0].ContentHash != poster.ContentHash("hello", "https://example.com/", nil) {
+			t.Errorf("Dispatcher.Post() records = %+v, want one record.", h.records)
+		}
+		results := d.Post(context.Background(), &poster.Message{Text: " hello\n", Source: poster.Source{Type: poster.SourceFeed, ID: "https://example.com/"}})
+		if results[0].Skipped != tc.skipped || results[0].URI != p.uri {
+			t.Errorf("Dispatcher.Post() (force: %v) = %+v, want skipped %v.", tc.force, results[0], tc.skipped)
+		}
+		if len(p.msgs) != tc.posts {
+			t.Errorf("Dispatcher.Post() (force: %v) posts %v times, want %v.", tc.force, len(p.msgs), tc.posts)
+		}
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{"a.jpg": "image a", "b.jpg": "image b", "c.jpg": "image a"}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatalf("os.WriteFile() error = \"%+v\", want nil.", err)
+		}
+	}
+	imgA := poster.Images{{Path: filepath.Join(dir, "a.jpg")}}
+	imgB := poster.Images{{Path: filepath.Join(dir, "b.jpg")}}
+	imgC := poster.Images{{Path: filepath.Join(dir, "c.jpg"), Alt: "copy of a"}}
+	base := poster.ContentHash("hello", "https://example.com/", imgA)
+	testCases := []struct {
+		text   string
+		link   string
+		images poster.Images
+		same   bool
+	}{
+		{text: " hello\n", link: "https://example.com/", images: imgA, same: true},
+		{text: "hello", link: "https://example.com/", images: imgC, same: true}, // same image content in other file
+		{text: "hello", link: "https://example.com/other", images: imgA, same: false},
+		{text: "hello", link: "", images: imgA, same: false},
+		{text: "hello", link: "https://example.com/", images: imgB, same: false},
+		{text: "hello", link: "https://example.com/", images: nil, same: false},
+	}
+	for _, tc := range testCases {
+		if got := poster.ContentHash(tc.text, tc.link, tc.images); (got == base) != tc.same {
+			t.Errorf("ContentHash(%q, %q, %v) = %v, same as base %v, want %v.", tc.text, tc.link, tc.images.Files(), got, got == base, tc.same)
+		}
+	}
+}
+
+type testPreviewer struct {
+	testPoster
+}
+
+func (p *testPreviewer) Preview(_ context.Context, msg *poster.Message) (*poster.Preview, error) {
+	return &poster.Preview{Name: p.name, Posts: []*poster.PreviewPost{{Text: msg.Text, Length: len(msg.Text), Payload: msg.Text}}}, nil
+}
+
+func TestDispatcherPreview(t *testing.T) {
+	p1 := &testPreviewer{testPoster: testPoster{name: "foo"}}
+	p2 := &testPoster{name: "bar"}
+	d := poster.NewDispatcher(nil, p1, p2)
+	previews := d.Preview(context.Background(), &poster.Message{Text: "hello"})
+	if len(previews) != 2 {
+		t.Fatalf("Dispatcher.Preview() returns %v previews, want %v.", len(previews), 2)
+	}
+	if previews[0].Err != nil || len(previews[0].Posts) != 1 || previews[0].Posts[0].Length != 5 {
+		t.Errorf("Dispatcher.Preview()[0] = %+v, want one post with length %v.", previews[0], 5)
+	}
+	if !errors.Is(previews[1].Err, poster.ErrNoPreview) {
+		t.Errorf("Dispatcher.Preview()[1] error = %v, want %v.", previews[1].Err, poster.ErrNoPreview)
+	}
+	if len(p1.msgs) != 0 || len(p2.msgs) != 0 {
+		t.Errorf("Dispatcher.Preview() must not post message.")
+	}
+}
+
+func TestMessageImageFiles(t *testing.T) {
+	testCases := []struct {
+		msg   *poster.Message
+		files []string
+	}{
+		{msg: nil, files: nil},
+		{msg: &poster.Message{}, files: nil},
+		{msg: &poster.Message{Images: []*poster.Image{{Path: "a.jpg"}, nil, {Path: ""}, {Path: "b.png", Alt: "b"}}}, files: []string{"a.jpg", "b.png"}},
+	}
+	for _, tc := range testCases {
+		files := tc.msg.ImageFiles()
+		if len(files) != len(tc.files) {
+			t.Errorf("Message.ImageFiles() = %v, want %v.", files, tc.files)
+			continue
+		}
+		for i := range files {
+			if files[i] != tc.files[i] {
+				t.Errorf("Message.ImageFiles() = %v, want %v.", files, tc.files)
+				break
+			}
+		}
+	}
+}
+
+func TestParseImages(t *testing.T) {
+	testCases := []struct {
+		files  []string
+		alts   []string
+		images poster.Images
+	}{
+		{files: nil, alts: nil, images: nil},
+		{files: []string{"a.jpg"}, alts: nil, images: poster.Images{{Path: "a.jpg"}}},
+		{files: []string{"a.jpg::Alt text, with comma"}, alts: nil, images: poster.Images{{Path: "a.jpg", Alt: "Alt text, with comma"}}},
+		{files: []string{"a.jpg", "b.jpg::b"}, alts: []string{"alt a"}, images: poster.Images{{Path: "a.jpg", Alt: "alt a"}, {Path: "b.jpg", Alt: "b"}}},
+		{files: []string{"a.jpg::a", " "}, alts: []string{"", "ignored"}, images: poster.Images{{Path: "a.jpg", Alt: "a"}}},
+	}
+	for _, tc := range testCases {
+		images := poster.ParseImages(tc.files, tc.alts)
+		if len(images) != len(tc.images) {
+			t.Errorf("ParseImages(%q, %q) = %v, want %v.", tc.files, tc.alts, images, tc.images)
+			continue
+		}
+		for i := range images {
+			if *images[i] != *tc.images[i] {
+				t.Errorf("ParseImages(%q, %q)[%d] = %+v, want %+v.", tc.files, tc.alts, i, images[i], tc.images[i])
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,90 @@
+package poster
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// Source types of message.
+const (
+	SourceAPOD     = "apod"
+	SourceWebpage  = "webpage"
+	SourceFeed     = "feed"
+	SourceCalendar = "calendar"
+)
+
+// Source is information of source data for message (APOD date, Web page URL, calendar range, ...).
+type Source struct {
+	Type string
+	ID   string
+}
+
+// Record is history record of posted message.
+type Record struct {
+	Destination string
+	URI         string
+	SourceType  string
+	Source      string
+	ContentHash string
+	Text        string
+	PostedAt    time.Time
+}
+
+// History is interface for post history storage.
+type History interface {
+	// FindPosted method returns the latest Record of same content posted to destination. If not posted, returns nil.
+	FindPosted(ctx context.Context, destination, contentHash string) (*Record, error)
+	// AddPosted method stores Record of posted message.
+	AddPosted(ctx context.Context, rec *Record) error
+}
+
+// ContentHash function returns hash value (SHA-256, hex string) of message content.
+// Content is text, link (ID of source: URL of Web page, date of APOD, ...) and images (by content of file).
+// White spaces at the beginning and end of text are ignored.
+// Hash value of text only content (no link and no images) is the same as hash value of text.
+func ContentHash(text, link string, images Images) string {
+	h := sha256.New()
+	_, _ = io.WriteString(h, strings.TrimSpace(text))
+	if len(link) > 0 {
+		_, _ = io.WriteString(h, "\x00link:"+link)
+	}
+	for _, path := range images.Files() {
+		_, _ = io.WriteString(h, "\x00image:"+imageDigest(path))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// imageDigest function returns hash value of image file content.
+// If the file cannot be read, returns path of the file instead.
+func imageDigest(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return path
+	}
+	defer file.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return path
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
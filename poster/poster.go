@@ -0,0 +1,159 @@
+package poster
+
+import (
+	"context"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+const (
+	// AltSeparator is separator between path and alt text of image ("path::alt").
+	AltSeparator = "::"
+)
+
+// Image is image file information in Message.
+type Image struct {
+	Path string `json:"path"`
+	Alt  string `json:"alt,omitempty"`
+}
+
+// Images is list of Image.
+type Images []*Image
+
+// Files method returns list of image file paths.
+func (list Images) Files() []string {
+	if len(list) == 0 {
+		return nil
+	}
+	files := make([]string, 0, len(list))
+	for _, img := range list {
+		if img != nil && len(img.Path) > 0 {
+			files = append(files, img.Path)
+		}
+	}
+	return files
+}
+
+// Alts method returns list of alt texts for image files. The order is same as Files method.
+func (list Images) Alts() []string {
+	if len(list) == 0 {
+		return nil
+	}
+	alts := make([]string, 0, len(list))
+	for _, img := range list {
+		if img != nil && len(img.Path) > 0 {
+			alts = append(alts, img.Alt)
+		}
+	}
+	return alts
+}
+
+// Message is neutral information of post message for all destinations.
+type Message struct {
+	Text       string
+	Images     Images
+	ReplyTo    string
+	Visibility string   // visibility of post (for destinations supporting it, e.g. Mastodon)
+	Posted     []string // URIs (URLs) of chunks already posted in thread; posting is resumed from next chunk
+	Source     Source
+}
+
+// ImageFiles method returns list of image file paths.
+func (msg *Message) ImageFiles() []string {
+	if msg == nil {
+		return nil
+	}
+	return msg.Images.Files()
+}
+
+// ImageAlts method returns list of alt texts for image files. The order is same as ImageFiles method.
+func (msg *Message) ImageAlts() []string {
+	if msg == nil {
+		return nil
+	}
+	return msg.Images.Alts()
+}
+
+// ContentHash method returns hash value of message content (text, link of source and images).
+func (msg *Message) ContentHash() string {
+	if msg == nil {
+		return ""
+	}
+	return ContentHash(msg.Text, msg.Source.ID, msg.Images)
+}
+
+// ParseImages function makes list of Image from image file flags and alt text flags.
+// Each image file may have alt text with "path::alt" syntax, or alt text is given by alts in the same order.
+func ParseImages(files, alts []string) Images {
+	if len(files) == 0 {
+		return nil
+	}
+	list := make(Images, 0, len(files))
+	for i, f := range files {
+		img := &Image{Path: strings.TrimSpace(f)}
+		if path, alt, ok := strings.Cut(f, AltSeparator); ok {
+			img.Path = strings.TrimSpace(path)
+			img.Alt = strings.TrimSpace(alt)
+		}
+		if i < len(alts) && len(strings.TrimSpace(alts[i])) > 0 {
+			img.Alt = strings.TrimSpace(alts[i])
+		}
+		if len(img.Path) > 0 {
+			list = append(list, img)
+		}
+	}
+	return list
+}
+
+// ThreadError is error in posting thread which fails partway.
+type ThreadError struct {
+	Posted []string // URIs (URLs) of posted chunks in order
+	Err    error
+}
+
+func (e *ThreadError) Error() string {
+	if e == nil || e.Err == nil {
+		return "<nil>"
+	}
+	return e.Err.Error()
+}
+
+func (e *ThreadError) Unwrap() error {
+	if e == nil {
+		return nil
+	}
+	return e.Err
+}
+
+// PostedChunks function returns URIs of chunks posted before error in posting thread (see ThreadError).
+func PostedChunks(err error) []string {
+	var te *ThreadError
+	if errs.As(err, &te) {
+		return te.Posted
+	}
+	return nil
+}
+
+// Poster is interface for posting message to destination (Bluesky, Mastodon, ...).
+type Poster interface {
+	// Name method returns name of destination.
+	Name() string
+	// Post method posts message to destination, and returns URI (URL) of the post.
+	Post(context.Context, *Message) (string, error)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,121 @@
+package poster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/ecode"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrNoPreview = errors.New("preview is not supported")
+)
+
+// PreviewImage is information of image file in Preview.
+type PreviewImage struct {
+	Path         string `json:"path"`
+	Alt          string `json:"alt,omitempty"`
+	OriginalSize int    `json:"original_size"`
+	Size         int    `json:"size"`
+}
+
+// PreviewPost is information of a post (status) in Preview.
+type PreviewPost struct {
+	Text      string          `json:"text"`
+	Length    int             `json:"length"`
+	MaxLength int             `json:"max_length,omitempty"`
+	Images    []*PreviewImage `json:"images,omitempty"`
+	Details   []string        `json:"details,omitempty"`
+	Payload   any             `json:"payload"`
+}
+
+// Preview is final payloads for a destination, made without posting.
+type Preview struct {
+	Name  string         `json:"destination"`
+	Posts []*PreviewPost `json:"posts,omitempty"`
+	Err   error          `json:"-"`
+}
+
+// Previewer is interface for making final payloads without posting (dry-run).
+type Previewer interface {
+	Preview(context.Context, *Message) (*Preview, error)
+}
+
+// Preview method makes final payloads for all destinations without posting.
+func (d *Dispatcher) Preview(ctx context.Context, msg *Message) []*Preview {
+	if d == nil {
+		return nil
+	}
+	previews := make([]*Preview, 0, len(d.posters))
+	for _, p := range d.posters {
+		pv := &Preview{Name: p.Name()}
+		if msg == nil {
+			pv.Err = errs.Wrap(ecode.ErrNullPointer, errs.WithContext("destination", pv.Name))
+		} else if pp, ok := p.(Previewer); !ok {
+			pv.Err = errs.Wrap(ErrNoPreview, errs.WithContext("destination", pv.Name))
+		} else if res, err := pp.Preview(ctx, msg); err != nil {
+			pv.Err = errs.Wrap(err, errs.WithContext("destination", pv.Name))
+		} else {
+			pv.Posts = res.Posts
+		}
+		if pv.Err != nil {
+			d.Logger().Error("error in making preview", zap.String("destination", pv.Name), zap.Object("error", zapobject.New(pv.Err)))
+		}
+		previews = append(previews, pv)
+	}
+	return previews
+}
+
+// Encode method outputs preview to io.Writer by human-readable text or JSON format.
+func (pv *Preview) Encode(w io.Writer, jsonFlag bool) error {
+	if pv == nil {
+		return nil
+	}
+	if jsonFlag {
+		if err := json.NewEncoder(w).Encode(pv); err != nil {
+			return errs.Wrap(err, errs.WithContext("destination", pv.Name))
+		}
+		return nil
+	}
+	for i, post := range pv.Posts {
+		fmt.Fprintf(w, "=== %s (%d/%d) ===\n", pv.Name, i+1, len(pv.Posts))
+		if post.MaxLength > 0 {
+			fmt.Fprintf(w, "Length: %d/%d\n", post.Length, post.MaxLength)
+		} else {
+			fmt.Fprintf(w, "Length: %d\n", post.Length)
+		}
+		for _, img := range post.Images {
+			fmt.Fprintf(w, " Image: %s (%d -> %d bytes)\n", img.Path, img.OriginalSize, img.Size)
+			if len(img.Alt) > 0 {
+				fmt.Fprintf(w, "   Alt: %s\n", strings.ReplaceAll(img.Alt, "\n", " "))
+			}
+		}
+		for _, d := range post.Details {
+			fmt.Fprintf(w, "  %s\n", d)
+		}
+		fmt.Fprintf(w, "\n%s\n\n", post.Text)
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,156 @@
+package poster
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// Span is byte range in text which must not be split (link, mention, tag, ...).
+type Span struct {
+	Start int
+	End   int
+}
+
+// GraphemeCount function returns number of graphemes in text.
+func GraphemeCount(text string) int {
+	return uniseg.GraphemeClusterCount(text)
+}
+
+// SplitText function splits text into chunks of up to max length measured by length function.
+// Text is split on line-breaking (word) boundaries, and never split inside protected spans.
+// Protected span longer than max is put in its own chunk as it is (the chunk exceeds max).
+// If length function is nil, length is number of graphemes.
+func SplitText(text string, max int, length func(string) int, protected []Span) []string {
+	if length == nil {
+		length = GraphemeCount
+	}
+	trimmed := strings.TrimSpace(text)
+	if len(trimmed) == 0 {
+		return []string{}
+	}
+	if max <= 0 || length(trimmed) <= max {
+		return []string{trimmed}
+	}
+
+	chunks := []string{}
+	bld := strings.Builder{}
+	count := 0
+	flush := func() {
+		if s := strings.TrimSpace(bld.String()); len(s) > 0 {
+			chunks = append(chunks, s)
+		}
+		bld.Reset()
+		count = 0
+	}
+	offset := 0
+	for _, token := range splitTokens(text, protected) {
+		start := offset // byte position of token in text
+		offset += len(token)
+		n := length(token)
+		if count+length(strings.TrimRight(token, " \t\r\n")) <= max {
+			bld.WriteString(token)
+			count += n
+			continue
+		}
+		flush()
+		trimmed := strings.TrimLeft(token, " \t\r\n")
+		start += len(token) - len(trimmed)
+		token = trimmed
+		n = length(token)
+		for n > max {
+			// too long token: split on grapheme boundaries outside protected spans
+			head, _ := splitGraphemes(token, max, length)
+			pos := splitPosition(protected, start, len(head), len(token))
+			if s := strings.TrimSpace(token[:pos]); len(s) > 0 {
+				chunks = append(chunks, s)
+			}
+			token = strings.TrimLeft(token[pos:], " \t\r\n")
+			start = offset - len(token)
+			n = length(token)
+		}
+		bld.WriteString(token)
+		count = n
+	}
+	flush()
+	return chunks
+}
+
+// splitTokens function splits text into tokens on line-breaking opportunities.
+// Protected spans are kept in a token.
+func splitTokens(text string, protected []Span) []string {
+	tokens := []string{}
+	pos := 0
+	start := 0
+	state := -1
+	rest := text
+	for len(rest) > 0 {
+		var segment string
+		segment, rest, _, state = uniseg.FirstLineSegmentInString(rest, state)
+		pos += len(segment)
+		if insideSpans(protected, pos) {
+			continue
+		}
+		tokens = append(tokens, text[start:pos])
+		start = pos
+	}
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+	return tokens
+}
+
+// insideSpans function returns true if byte position is inside (not on edge of) any span.
+func insideSpans(list []Span, pos int) bool {
+	for _, s := range list {
+		if s.Start < pos && pos < s.End {
+			return true
+		}
+	}
+	return false
+}
+
+// splitPosition function returns split position in token (start is byte position of token in text) moved out of protected spans.
+// If pos is inside a span, pos is moved to the beginning of the span, or to the end of the span if the span begins the token.
+func splitPosition(list []Span, start, pos, size int) int {
+	for _, s := range list {
+		if s.Start < start+pos && start+pos < s.End {
+			if s.Start > start {
+				return s.Start - start
+			}
+			return min(s.End-start, size)
+		}
+	}
+	return pos
+}
+
+// splitGraphemes function splits text at max length on grapheme boundaries.
+func splitGraphemes(text string, max int, length func(string) int) (string, string) {
+	pos := 0
+	state := -1
+	rest := text
+	for len(rest) > 0 {
+		cluster, next, _, nextState := uniseg.FirstGraphemeClusterInString(rest, state)
+		if pos > 0 && length(text[:pos+len(cluster)]) > max {
+			break
+		}
+		pos += len(cluster)
+		rest, state = next, nextState
+	}
+	return text[:pos], text[pos:]
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
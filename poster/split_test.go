@@ -0,0 +1,44 @@
+package poster_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/poster"
+)
+
+func TestSplitTextProtected(t *testing.T) {
+	testCases := []struct {
+		name      string
+		text      string
+		max       int
+		protected []poster.Span
+		chunks    []string
+	}{
+		{name: "no span", text: "abcdefghij", max: 4, chunks: []string{"abcd", "efgh", "ij"}},
+		{name: "long span", text: "https://example.com/foo", max: 8, protected: []poster.Span{{Start: 0, End: 23}}, chunks: []string{"https://example.com/foo"}},
+		{name: "long span in token", text: "abc:https://example.com/foo;xyz", max: 8, protected: []poster.Span{{Start: 4, End: 27}}, chunks: []string{"abc:", "https://example.com/foo", ";xyz"}},
+		{name: "short span in token", text: "abcdef[link]gh", max: 8, protected: []poster.Span{{Start: 6, End: 12}}, chunks: []string{"abcdef", "[link]gh"}},
+	}
+	for _, tc := range testCases {
+		chunks := poster.SplitText(tc.text, tc.max, nil, tc.protected)
+		if strings.Join(chunks, "|") != strings.Join(tc.chunks, "|") || len(chunks) != len(tc.chunks) {
+			t.Errorf("[%s] SplitText() = %q, want %q.", tc.name, chunks, tc.chunks)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,117 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/poster"
+)
+
+// Item is information of queue item.
+type Item struct {
+	ID          uint          `json:"id"`
+	Destination string        `json:"destination"`
+	Status      string        `json:"status"`
+	Text        string        `json:"text"`
+	Images      poster.Images `json:"images,omitempty"`
+	SourceType  string        `json:"source_type,omitempty"`
+	Source      string        `json:"source,omitempty"`
+	Visibility  string        `json:"visibility,omitempty"`
+	Force       bool          `json:"force,omitempty"`
+	NotBefore   time.Time     `json:"not_before"`
+	NextAttempt time.Time     `json:"next_attempt"`
+	Attempts    int           `json:"attempts"`
+	LastError   string        `json:"last_error,omitempty"`
+	URI         string        `json:"uri,omitempty"`
+	Posted      []string      `json:"posted,omitempty"`
+	PostedAt    *time.Time    `json:"posted_at,omitempty"`
+}
+
+func newItem(data *model.QueueItem) *Item {
+	item := &Item{
+		ID:          data.ID,
+		Destination: data.Destination,
+		Status:      data.Status,
+		Text:        data.Text,
+		Images:      images(data),
+		SourceType:  data.SourceType,
+		Source:      data.Source,
+		Visibility:  data.Visibility,
+		Force:       data.Force,
+		NotBefore:   data.NotBefore,
+		NextAttempt: data.NextAttempt,
+		Attempts:    data.Attempts,
+		LastError:   data.LastError,
+		URI:         data.URI,
+		Posted:      posted(data),
+	}
+	if data.PostedAt.Valid {
+		tm := data.PostedAt.Time
+		item.PostedAt = &tm
+	}
+	return item
+}
+
+// Message method returns poster.Message instance of queue item.
+func (item *Item) Message() *poster.Message {
+	return &poster.Message{
+		Text:       item.Text,
+		Images:     item.Images,
+		Visibility: item.Visibility,
+		Posted:     item.Posted,
+		Source:     poster.Source{Type: item.SourceType, ID: item.Source},
+	}
+}
+
+const timeLayout = "2006-01-02 15:04:05"
+
+// Encode function outputs list of queue items to io.Writer by table or JSON format.
+func Encode(w io.Writer, items []*Item, jsonFlag bool) error {
+	if jsonFlag {
+		if items == nil {
+			items = []*Item{}
+		}
+		if err := json.NewEncoder(w).Encode(items); err != nil {
+			return errs.Wrap(err)
+		}
+		return nil
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATUS\tDESTINATION\tNEXT ATTEMPT\tATTEMPTS\tTEXT\tRESULT")
+	for _, item := range items {
+		result := item.URI
+		if len(item.LastError) > 0 {
+			result = item.LastError
+		}
+		text, _, _ := strings.Cut(strings.TrimSpace(item.Text), "\n")
+		if rs := []rune(text); len(rs) > 30 {
+			text = string(rs[:29]) + "…"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%d\t%s\t%s\n", item.ID, item.Status, item.Destination, item.NextAttempt.Local().Format(timeLayout), item.Attempts, text, result)
+	}
+	if err := tw.Flush(); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,268 @@
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/db"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/poster"
+	"github.com/ipfs/go-log/v2"
+	"go.uber.org/zap"
+)
+
+// Status of queue item.
+const (
+	StatusPending = "pending"
+	StatusRunning = "running"
+	StatusDone    = "done"
+	StatusFailed  = "failed"
+)
+
+// queueDir is sub-directory of cache directory for image files in queue.
+const queueDir = "queue"
+
+// Queue is persistent outbox for posting messages.
+type Queue struct {
+	repos  *db.Repository
+	dir    string
+	logger *log.ZapEventLogger
+}
+
+// New function creates new Queue instance.
+func New(ctx context.Context, cacheDir string, logger *log.ZapEventLogger) (*Queue, error) {
+	repos, err := db.Open(ctx, cacheDir, logger)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("cache_dir", cacheDir))
+	}
+	return NewWithRepository(cacheDir, repos, logger), nil
+}
+
+// NewWithRepository function creates new Queue instance with opened database.
+func NewWithRepository(cacheDir string, repos *db.Repository, logger *log.ZapEventLogger) *Queue {
+	return &Queue{repos: repos, dir: filepath.Join(cacheDir, queueDir), logger: logger}
+}
+
+// Logger method returns zap.Logger instance.
+func (q *Queue) Logger() *zap.Logger {
+	if q == nil || q.logger == nil {
+		return logger.Nop().Desugar()
+	}
+	return q.logger.Desugar()
+}
+
+// Enqueue method adds message to queue for each destination, and returns queued items.
+// Message is not posted before notBefore time (zero value means now).
+// Image files are copied to queue directory, because original files may be temporary.
+func (q *Queue) Enqueue(ctx context.Context, destinations []string, msg *poster.Message, notBefore time.Time, force bool) ([]*Item, error) {
+	if q == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	if msg == nil || len(msg.Text) == 0 {
+		return nil, errs.Wrap(ecode.ErrNoContent)
+	}
+	if notBefore.IsZero() {
+		notBefore = time.Now()
+	}
+	data := make([]*model.QueueItem, 0, len(destinations))
+	// remove image files copied in this call on error
+	cleanup := func(imgs poster.Images) {
+		removeFiles(imgs)
+		for _, d := range data {
+			removeImages(d)
+		}
+	}
+	for _, dest := range destinations {
+		imgs, err := q.copyImages(msg.Images)
+		if err != nil {
+			cleanup(nil)
+			return nil, errs.Wrap(err, errs.WithContext("destination", dest))
+		}
+		b, err := json.Marshal(imgs)
+		if err != nil {
+			cleanup(imgs)
+			return nil, errs.Wrap(err, errs.WithContext("destination", dest))
+		}
+		data = append(data, &model.QueueItem{
+			Destination: dest,
+			Status:      StatusPending,
+			Text:        msg.Text,
+			Images:      string(b),
+			SourceType:  msg.Source.Type,
+			Source:      msg.Source.ID,
+			Visibility:  msg.Visibility,
+			Force:       force,
+			NotBefore:   notBefore,
+			NextAttempt: notBefore,
+		})
+	}
+	if err := q.repos.InsertQueueItems(ctx, data); err != nil {
+		cleanup(nil)
+		return nil, errs.Wrap(err)
+	}
+	items := make([]*Item, 0, len(data))
+	for _, d := range data {
+		items = append(items, newItem(d))
+		q.Logger().Info("enqueue message", zap.Uint("id", d.ID), zap.String("destination", d.Destination), zap.Time("not_before", d.NotBefore))
+	}
+	return items, nil
+}
+
+// List method returns list of queue items by status (all items if status is empty).
+func (q *Queue) List(ctx context.Context, status string) ([]*Item, error) {
+	if q == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := q.repos.FindQueueItems(ctx, status)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	items := make([]*Item, 0, len(data))
+	for _, d := range data {
+		items = append(items, newItem(d))
+	}
+	return items, nil
+}
+
+// Retry method resets failed (pending or running) item to retry posting immediately.
+// Chunks of thread already posted are not posted again.
+func (q *Queue) Retry(ctx context.Context, id uint) (*Item, error) {
+	if q == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := q.find(ctx, id)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if data.Status == StatusDone {
+		return nil, errs.Wrap(ecode.ErrNoContent, errs.WithContext("id", id), errs.WithContext("status", data.Status))
+	}
+	data.Status = StatusPending
+	data.Attempts = 0
+	data.NextAttempt = time.Now()
+	if err := q.repos.UpdateQueueItem(ctx, data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return newItem(data), nil
+}
+
+// Delete method removes item and its image files from queue.
+func (q *Queue) Delete(ctx context.Context, id uint) error {
+	if q == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	data, err := q.find(ctx, id)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := q.repos.DeleteQueueItem(ctx, id); err != nil {
+		return errs.Wrap(err)
+	}
+	removeImages(data)
+	return nil
+}
+
+func (q *Queue) find(ctx context.Context, id uint) (*model.QueueItem, error) {
+	data, err := q.repos.FindQueueItemByID(ctx, id)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("id", id))
+	}
+	if data == nil {
+		return nil, errs.Wrap(ecode.ErrNoContent, errs.WithContext("id", id))
+	}
+	return data, nil
+}
+
+func (q *Queue) copyImages(imgs poster.Images) (poster.Images, error) {
+	if len(imgs) == 0 {
+		return nil, nil
+	}
+	if err := os.MkdirAll(q.dir, 0700); err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("dir", q.dir))
+	}
+	list := make(poster.Images, 0, len(imgs))
+	for _, img := range imgs {
+		if img == nil || len(img.Path) == 0 {
+			continue
+		}
+		path, err := copyFile(img.Path, q.dir)
+		if err != nil {
+			removeFiles(list)
+			return nil, errs.Wrap(err)
+		}
+		list = append(list, &poster.Image{Path: path, Alt: img.Alt})
+	}
+	return list, nil
+}
+
+func copyFile(src, dir string) (string, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return "", errs.Wrap(err, errs.WithContext("path", src))
+	}
+	defer in.Close()
+	out, err := os.CreateTemp(dir, "image-*"+filepath.Ext(src))
+	if err != nil {
+		return "", errs.Wrap(err, errs.WithContext("dir", dir))
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, in); err != nil {
+		_ = os.Remove(out.Name())
+		return "", errs.Wrap(err, errs.WithContext("path", src))
+	}
+	return out.Name(), nil
+}
+
+func images(data *model.QueueItem) poster.Images {
+	var imgs poster.Images
+	if len(data.Images) > 0 {
+		_ = json.Unmarshal([]byte(data.Images), &imgs)
+	}
+	return imgs
+}
+
+func posted(data *model.QueueItem) []string {
+	var list []string
+	if len(data.Posted) > 0 {
+		_ = json.Unmarshal([]byte(data.Posted), &list)
+	}
+	return list
+}
+
+func setPosted(data *model.QueueItem, list []string) {
+	if b, err := json.Marshal(list); err == nil {
+		data.Posted = string(b)
+	}
+}
+
+func removeImages(data *model.QueueItem) {
+	removeFiles(images(data))
+}
+
+func removeFiles(imgs poster.Images) {
+	for _, path := range imgs.Files() {
+		_ = os.Remove(path)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,243 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/poster"
+	"github.com/goark/toolbox/queue"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		s   string
+		tm  time.Time
+		err bool
+	}{
+		{s: "", tm: time.Time{}, err: false},
+		{s: "30m", tm: now.Add(30 * time.Minute), err: false},
+		{s: "+2h", tm: now.Add(2 * time.Hour), err: false},
+		{s: "2026-10-19T09:00:00+09:00", tm: time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), err: false},
+		{s: "2026-10-19 21:30", tm: time.Date(2026, time.October, 19, 21, 30, 0, 0, time.UTC), err: false},
+		{s: "2026-10-20", tm: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), err: false},
+		{s: "tomorrow", tm: time.Time{}, err: true},
+	}
+	for _, tc := range testCases {
+		tm, err := queue.ParseTime(tc.s, now)
+		if (err != nil) != tc.err {
+			t.Errorf("ParseTime(%q) error = \"%+v\", want error %v.", tc.s, err, tc.err)
+		}
+		if !tm.Equal(tc.tm) {
+			t.Errorf("ParseTime(%q) = %v, want %v.", tc.s, tm, tc.tm)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	testCases := []struct {
+		attempts int
+		delay    time.Duration
+	}{
+		{attempts: 1, delay: time.Minute},
+		{attempts: 2, delay: 2 * time.Minute},
+		{attempts: 4, delay: 8 * time.Minute},
+		{attempts: 10, delay: time.Hour},
+	}
+	for _, tc := range testCases {
+		if d := queue.Backoff(tc.attempts, time.Minute, time.Hour); d != tc.delay {
+			t.Errorf("Backoff(%v) = %v, want %v.", tc.attempts, d, tc.delay)
+		}
+	}
+}
+
+type testPoster struct {
+	name string
+	errs []error
+	msgs []*poster.Message
+}
+
+func (p *testPoster) Name() string { return p.name }
+
+func (p *testPoster) Post(_ context.Context, msg *poster.Message) (string, error) {
+	p.msgs = append(p.msgs, msg)
+	if len(p.errs) > 0 {
+		err := p.errs[0]
+		p.errs = p.errs[1:]
+		if err != nil {
+			return "", err
+		}
+	}
+	return "https://example.com/" + p.name, nil
+}
+
+func TestRunner(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	img := filepath.Join(dir, "image.jpg")
+	if err := os.WriteFile(img, []byte("image"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	q, err := queue.New(ctx, testDir, logger.Nop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &poster.Message{Text: "hello", Images: poster.Images{{Path: img, Alt: "alt"}}, Source: poster.Source{Type: poster.SourceWebpage, ID: "https://example.com/"}}
+	items, err := q.Enqueue(ctx, []string{"foo", "bar", "baz"}, msg, time.Time{}, false)
+	if err != nil {
+		t.Fatalf("Queue.Enqueue() error = \"%+v\", want nil.", err)
+	}
+	if len(items) != 3 || len(items[0].Images) != 1 || items[0].Images[0].Path == img {
+		t.Fatalf("Queue.Enqueue() = %+v, want 3 items with copied image.", items)
+	}
+	copied := items[0].Images[0].Path
+
+	foo := &testPoster{name: "foo", errs: []error{errors.New("temporary error")}}
+	bar := &testPoster{name: "bar", errs: []error{errs.Wrap(ecode.ErrTooLargeImage)}}
+	runner := q.NewRunner(nil, foo, bar) // no "baz" destination
+	runner.BaseDelay = 0
+
+	// first run: foo is retried, bar is failed (permanent error)
+	res, err := runner.Run(ctx)
+	if err != nil {
+		t.Fatalf("Runner.Run() error = \"%+v\", want nil.", err)
+	}
+	if len(res) != 2 || res[0].Status != queue.StatusPending || res[0].Attempts != 1 || res[1].Status != queue.StatusFailed {
+		t.Errorf("Runner.Run() = %+v, want pending foo and failed bar.", res)
+	}
+	// second run: foo is done
+	res, err = runner.Run(ctx)
+	if err != nil {
+		t.Fatalf("Runner.Run() error = \"%+v\", want nil.", err)
+	}
+	if len(res) != 1 || res[0].Status != queue.StatusDone || res[0].URI != "https://example.com/foo" {
+		t.Errorf("Runner.Run() = %+v, want done foo.", res)
+	}
+	if len(foo.msgs) != 2 || foo.msgs[1].Text != "hello" || foo.msgs[1].Source.ID != "https://example.com/" || foo.msgs[1].ImageAlts()[0] != "alt" {
+		t.Errorf("Runner.Run() posts %+v, want queued message.", foo.msgs)
+	}
+	if _, err := os.Stat(copied); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("image file in queue is not removed: %v", err)
+	}
+
+	// failed and pending items are kept
+	for status, n := range map[string]int{queue.StatusPending: 1, queue.StatusFailed: 1, queue.StatusDone: 1} {
+		list, err := q.List(ctx, status)
+		if err != nil || len(list) != n {
+			t.Errorf("Queue.List(%q) = %v items (error: %v), want %v.", status, len(list), err, n)
+		}
+	}
+
+	// invalid interval of daemon
+	for _, interval := range []time.Duration{0, -time.Minute} {
+		if err := runner.Daemon(ctx, interval, nil); !errors.Is(err, ecode.ErrInvalidInterval) {
+			t.Errorf("Runner.Daemon(%v) error = \"%+v\", want \"%+v\".", interval, err, ecode.ErrInvalidInterval)
+		}
+	}
+}
+
+// threadPoster is poster which fails in posting second chunk of thread at first time.
+type threadPoster struct {
+	name  string
+	msgs  []*poster.Message
+	other *queue.Runner // runner racing with current runner
+	raced []*queue.Item
+}
+
+func (p *threadPoster) Name() string { return p.name }
+
+func (p *threadPoster) Post(ctx context.Context, msg *poster.Message) (string, error) {
+	p.msgs = append(p.msgs, msg)
+	if p.other != nil {
+		p.raced, _ = p.other.Run(ctx)
+	}
+	if len(msg.Posted) == 0 {
+		return "", errs.Wrap(&poster.ThreadError{Posted: []string{"https://example.com/thread/1"}, Err: errors.New("temporary error")})
+	}
+	return msg.Posted[0], nil
+}
+
+func TestRunnerResumeThread(t *testing.T) {
+	ctx := context.Background()
+	q, err := queue.New(ctx, testDir, logger.Nop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := q.Enqueue(ctx, []string{"thread"}, &poster.Message{Text: "long message"}, time.Time{}, false)
+	if err != nil {
+		t.Fatalf("Queue.Enqueue() error = \"%+v\", want nil.", err)
+	}
+	defer func() { _ = q.Delete(ctx, items[0].ID) }()
+
+	p := &threadPoster{name: "thread"}
+	p.other = q.NewRunner(nil, &threadPoster{name: "thread"})
+	runner := q.NewRunner(nil, p)
+	runner.BaseDelay = 0
+
+	// first run: second chunk is failed, and item is not delivered by racing runner (claimed)
+	res, err := runner.Run(ctx)
+	if err != nil {
+		t.Fatalf("Runner.Run() error = \"%+v\", want nil.", err)
+	}
+	if len(res) != 1 || res[0].Status != queue.StatusPending || strings.Join(res[0].Posted, " ") != "https://example.com/thread/1" {
+		t.Errorf("Runner.Run() = %+v, want pending item with posted chunk.", res)
+	}
+	if len(p.raced) != 0 {
+		t.Errorf("racing Runner.Run() = %+v, want no items.", p.raced)
+	}
+	// second run: thread is resumed from second chunk
+	res, err = runner.Run(ctx)
+	if err != nil {
+		t.Fatalf("Runner.Run() error = \"%+v\", want nil.", err)
+	}
+	if len(res) != 1 || res[0].Status != queue.StatusDone || res[0].URI != "https://example.com/thread/1" {
+		t.Errorf("Runner.Run() = %+v, want done item.", res)
+	}
+	if len(p.msgs) != 2 || strings.Join(p.msgs[1].Posted, " ") != "https://example.com/thread/1" {
+		t.Errorf("Runner.Run() posts %+v, want resumed message.", p.msgs)
+	}
+}
+
+func TestEnqueueRemoveCopiedImages(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	img := filepath.Join(dir, "image.jpg")
+	if err := os.WriteFile(img, []byte("image"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	q, err := queue.New(ctx, testDir, logger.Nop())
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, _ := filepath.Glob(filepath.Join(testDir, "queue", "*"))
+	msg := &poster.Message{Text: "hello", Images: poster.Images{{Path: img}, {Path: filepath.Join(dir, "not-exist.jpg")}}}
+	if _, err := q.Enqueue(ctx, []string{"foo", "bar"}, msg, time.Time{}, false); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Queue.Enqueue() error = \"%+v\", want \"%+v\".", err, os.ErrNotExist)
+	}
+	if after, _ := filepath.Glob(filepath.Join(testDir, "queue", "*")); len(after) != len(before) {
+		t.Errorf("image files in queue = %v, want %v.", after, before)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
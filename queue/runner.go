@@ -0,0 +1,196 @@
+package queue
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/poster"
+	"go.uber.org/zap"
+)
+
+// Default parameters for retry.
+const (
+	DefaultMaxAttempts = 5
+	DefaultBaseDelay   = time.Minute
+	DefaultMaxDelay    = time.Hour
+	DefaultInterval    = time.Minute
+)
+
+// Runner delivers queued messages to destinations.
+type Runner struct {
+	queue       *Queue
+	posters     map[string]poster.Poster
+	history     poster.History
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// NewRunner method creates new Runner instance with destinations and post history.
+func (q *Queue) NewRunner(history poster.History, posters ...poster.Poster) *Runner {
+	r := &Runner{
+		queue:       q,
+		posters:     map[string]poster.Poster{},
+		history:     history,
+		MaxAttempts: DefaultMaxAttempts,
+		BaseDelay:   DefaultBaseDelay,
+		MaxDelay:    DefaultMaxDelay,
+	}
+	for _, p := range posters {
+		if p != nil {
+			r.posters[p.Name()] = p
+		}
+	}
+	return r
+}
+
+// Run method delivers all pending items which are due, and returns processed items.
+// Each item is claimed (changed to running status) before delivery, so concurrent runners do not deliver the same item.
+// Items for unavailable destinations are left in queue.
+func (r *Runner) Run(ctx context.Context) ([]*Item, error) {
+	if r == nil || r.queue == nil {
+		return nil, errs.Wrap(ecode.ErrNullPointer)
+	}
+	list, err := r.queue.repos.FindDueQueueItems(ctx, StatusPending, time.Now())
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	items := make([]*Item, 0, len(list))
+	for _, data := range list {
+		if err := ctx.Err(); err != nil {
+			return items, errs.Wrap(err)
+		}
+		p, ok := r.posters[data.Destination]
+		if !ok {
+			r.queue.Logger().Warn("no destination for queue item", zap.Uint("id", data.ID), zap.String("destination", data.Destination))
+			continue
+		}
+		claimed, err := r.queue.repos.ClaimQueueItem(ctx, data.ID, StatusPending, StatusRunning)
+		if err != nil {
+			return items, errs.Wrap(err)
+		}
+		if !claimed {
+			r.queue.Logger().Debug("queue item is claimed by other runner", zap.Uint("id", data.ID))
+			continue
+		}
+		data.Status = StatusRunning
+		r.deliver(ctx, p, data)
+		// save result even if context is canceled, not to leave item in running status
+		if err := r.queue.repos.UpdateQueueItem(context.WithoutCancel(ctx), data); err != nil {
+			return items, errs.Wrap(err)
+		}
+		items = append(items, newItem(data))
+	}
+	return items, nil
+}
+
+// Daemon method runs delivery at every interval until context is canceled. Interval must be positive.
+func (r *Runner) Daemon(ctx context.Context, interval time.Duration, output func([]*Item)) error {
+	if r == nil || r.queue == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if interval <= 0 {
+		return errs.Wrap(ecode.ErrInvalidInterval, errs.WithContext("interval", interval.String()))
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		items, err := r.Run(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.queue.Logger().Error("error in delivering queue", zap.Object("error", zapobject.New(err)))
+		}
+		if output != nil && len(items) > 0 {
+			output(items)
+		}
+		select {
+		case <-ctx.Done():
+			r.queue.Logger().Info("stop queue daemon")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Runner) deliver(ctx context.Context, p poster.Poster, data *model.QueueItem) {
+	item := newItem(data)
+	dispatcher := poster.NewDispatcher(r.queue.logger, p)
+	dispatcher.SetHistory(r.history, data.Force)
+	res := dispatcher.Post(ctx, item.Message())[0]
+	data.Attempts++
+	if posted := poster.PostedChunks(res.Err); len(posted) > 0 {
+		// thread is resumed from next chunk in next attempt
+		setPosted(data, posted)
+	}
+	if res.Err == nil {
+		data.Status = StatusDone
+		data.URI = res.URI
+		data.LastError = ""
+		if res.Skipped {
+			data.LastError = "skipped (already posted)"
+		}
+		data.PostedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		removeImages(data)
+		return
+	}
+	data.LastError = res.Err.Error()
+	if IsPermanent(res.Err) || data.Attempts >= r.MaxAttempts {
+		data.Status = StatusFailed
+		r.queue.Logger().Error("give up posting queue item", zap.Uint("id", data.ID), zap.Int("attempts", data.Attempts), zap.Object("error", zapobject.New(res.Err)))
+		return
+	}
+	data.Status = StatusPending
+	data.NextAttempt = time.Now().Add(Backoff(data.Attempts, r.BaseDelay, r.MaxDelay))
+	r.queue.Logger().Info("retry posting queue item later", zap.Uint("id", data.ID), zap.Int("attempts", data.Attempts), zap.Time("next_attempt", data.NextAttempt))
+}
+
+// Backoff function returns delay before the next attempt (exponential backoff).
+func Backoff(attempts int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
+
+// IsPermanent function returns true if error is not transient (retry does not help).
+func IsPermanent(err error) bool {
+	for _, e := range []error{
+		ecode.ErrNullPointer,
+		ecode.ErrNoContent,
+		ecode.ErrTooLargeImage,
+		ecode.ErrInvalidBlueskyRecordURI,
+		os.ErrNotExist,
+	} {
+		if errs.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
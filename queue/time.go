@@ -0,0 +1,53 @@
+package queue
+
+import (
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	time.DateOnly,
+}
+
+// ParseTime function returns time from string for "not before" time.
+// String is duration from now ("30m", "2h"), RFC 3339 format, or local date and time ("YYYY-MM-DD HH:MM", "YYYY-MM-DD").
+// Empty string means zero time.
+func ParseTime(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(strings.TrimPrefix(s, "+")); err == nil {
+		return now.Add(d), nil
+	}
+	errList := &errs.Errors{}
+	for _, layout := range timeLayouts {
+		tm, err := time.ParseInLocation(layout, s, now.Location())
+		if err == nil {
+			return tm, nil
+		}
+		errList.Add(errs.Wrap(err, errs.WithContext("time_string", s), errs.WithContext("time_layout", layout)))
+	}
+	return time.Time{}, errList.ErrorOrNil()
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,127 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
+)
+
+// Job is scheduled job. Args are arguments of toolbox command (e.g. ["apod", "post", "--bluesky"]).
+//
+//   - Every: run at start and every interval (e.g. "30m")
+//   - At: run every day at the time ("HH:MM")
+//   - Day and At: run every month at the day and time
+type Job struct {
+	Name  string        `mapstructure:"name"`
+	Every time.Duration `mapstructure:"every"`
+	At    string        `mapstructure:"at"`
+	Day   int           `mapstructure:"day"`
+	Args  []string      `mapstructure:"args"`
+	hour  int
+	min   int
+}
+
+// reservedCommands are commands which cannot be scheduled.
+var reservedCommands = []string{"serve", "daemon", "help"}
+
+// validate method checks and parses settings of job.
+func (j *Job) validate() error {
+	if j == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	if len(j.Args) == 0 {
+		return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("detail", "no args"))
+	}
+	for _, c := range reservedCommands {
+		if strings.EqualFold(j.Args[0], c) {
+			return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("detail", "cannot schedule "+c))
+		}
+	}
+	if len(j.Name) == 0 {
+		j.Name = strings.Join(j.Args, " ")
+	}
+	switch {
+	case j.Every > 0:
+		if len(j.At) > 0 || j.Day != 0 {
+			return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("detail", "every cannot be used with at or day"))
+		}
+	case len(j.At) > 0:
+		tm, err := time.Parse("15:04", j.At)
+		if err != nil {
+			return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("at", j.At), errs.WithContext("detail", err.Error()))
+		}
+		j.hour, j.min = tm.Hour(), tm.Minute()
+		if j.Day < 0 || j.Day > 31 {
+			return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("day", j.Day))
+		}
+	default:
+		return errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("job", j.Name), errs.WithContext("detail", "no every or at"))
+	}
+	return nil
+}
+
+// First method returns time of the first run after start.
+func (j *Job) First(start time.Time) time.Time {
+	if j.Every > 0 {
+		return start
+	}
+	return j.Next(start)
+}
+
+// Next method returns time of the next run after t (time zone of t is used).
+func (j *Job) Next(t time.Time) time.Time {
+	if j.Every > 0 {
+		return t.Add(j.Every)
+	}
+	if j.Day == 0 {
+		next := time.Date(t.Year(), t.Month(), t.Day(), j.hour, j.min, 0, 0, t.Location())
+		if !next.After(t) {
+			next = time.Date(t.Year(), t.Month(), t.Day()+1, j.hour, j.min, 0, 0, t.Location())
+		}
+		return next
+	}
+	next := j.monthly(t.Year(), t.Month(), t.Location())
+	if !next.After(t) {
+		next = j.monthly(t.Year(), t.Month()+1, t.Location())
+	}
+	return next
+}
+
+// monthly method returns time of the day in month. If the day exceeds the end of month, the last day is used.
+func (j *Job) monthly(year int, month time.Month, loc *time.Location) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc).Day()
+	day := j.Day
+	if day > last {
+		day = last
+	}
+	return time.Date(year, month, day, j.hour, j.min, 0, 0, loc)
+}
+
+func (j *Job) String() string {
+	switch {
+	case j.Every > 0:
+		return fmt.Sprintf("%s (every %v)", j.Name, j.Every)
+	case j.Day > 0:
+		return fmt.Sprintf("%s (day %d at %s)", j.Name, j.Day, j.At)
+	default:
+		return fmt.Sprintf("%s (at %s)", j.Name, j.At)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,143 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/logger"
+	"github.com/ipfs/go-log/v2"
+	"go.uber.org/zap"
+)
+
+// Config is configuration of schedule (schedule section in config.yaml).
+type Config struct {
+	TimeZone string `mapstructure:"timezone"`
+	Jobs     []*Job `mapstructure:"jobs"`
+}
+
+// RunFunc is function for running job.
+type RunFunc func(context.Context, *Job) error
+
+// Scheduler runs jobs on schedule.
+type Scheduler struct {
+	jobs   []*Job
+	loc    *time.Location
+	logger *log.ZapEventLogger
+}
+
+// New function creates new Scheduler instance from configuration.
+func New(cfg *Config, logger *log.ZapEventLogger) (*Scheduler, error) {
+	if cfg == nil || len(cfg.Jobs) == 0 {
+		return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("detail", "no jobs"))
+	}
+	loc := time.Local
+	if len(cfg.TimeZone) > 0 {
+		l, err := time.LoadLocation(cfg.TimeZone)
+		if err != nil {
+			return nil, errs.Wrap(ecode.ErrInvalidSchedule, errs.WithContext("timezone", cfg.TimeZone), errs.WithContext("detail", err.Error()))
+		}
+		loc = l
+	}
+	for _, j := range cfg.Jobs {
+		if err := j.validate(); err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
+	return &Scheduler{jobs: cfg.Jobs, loc: loc, logger: logger}, nil
+}
+
+// Logger method returns zap.Logger instance.
+func (s *Scheduler) Logger() *zap.Logger {
+	if s == nil || s.logger == nil {
+		return logger.Nop().Desugar()
+	}
+	return s.logger.Desugar()
+}
+
+// Jobs method returns list of jobs.
+func (s *Scheduler) Jobs() []*Job {
+	if s == nil {
+		return nil
+	}
+	return s.jobs
+}
+
+// Location method returns time zone of schedule.
+func (s *Scheduler) Location() *time.Location {
+	if s == nil || s.loc == nil {
+		return time.Local
+	}
+	return s.loc
+}
+
+// Start method runs jobs on schedule until context is canceled.
+// Jobs are run one by one in the same process. Errors in jobs are logged and do not stop scheduler.
+func (s *Scheduler) Start(ctx context.Context, run RunFunc) error {
+	if s == nil || run == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	now := time.Now().In(s.Location())
+	next := make([]time.Time, len(s.jobs))
+	for i, j := range s.jobs {
+		next[i] = j.First(now)
+		s.Logger().Info("schedule job", zap.String("job", j.String()), zap.Time("next", next[i]))
+	}
+	for {
+		// wait for the earliest job
+		earliest := next[0]
+		for _, tm := range next[1:] {
+			if tm.Before(earliest) {
+				earliest = tm
+			}
+		}
+		timer := time.NewTimer(time.Until(earliest))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			s.Logger().Info("stop scheduler")
+			return nil
+		case <-timer.C:
+		}
+
+		// run due jobs
+		for i, j := range s.jobs {
+			if ctx.Err() != nil {
+				break
+			}
+			now := time.Now().In(s.Location())
+			if next[i].After(now) {
+				continue
+			}
+			s.Logger().Info("start job", zap.String("job", j.Name))
+			if err := run(ctx, j); err != nil {
+				s.Logger().Error("error in job", zap.String("job", j.Name), zap.Object("error", zapobject.New(err)))
+			} else {
+				s.Logger().Info("complete job", zap.String("job", j.Name))
+			}
+			next[i] = j.Next(next[i])
+			if now := time.Now().In(s.Location()); next[i].Before(now) {
+				// skip missed runs
+				next[i] = j.Next(now)
+			}
+			s.Logger().Debug("schedule job", zap.String("job", j.Name), zap.Time("next", next[i]))
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
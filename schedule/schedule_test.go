@@ -0,0 +1,71 @@
+package schedule_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/schedule"
+)
+
+func TestJobNext(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	testCases := []struct {
+		job  *schedule.Job
+		now  time.Time
+		next time.Time
+	}{
+		{job: &schedule.Job{Every: 30 * time.Minute, Args: []string{"feed", "post"}}, now: time.Date(2026, 1, 1, 0, 10, 0, 0, jst), next: time.Date(2026, 1, 1, 0, 40, 0, 0, jst)},
+		{job: &schedule.Job{At: "09:00", Args: []string{"apod", "post"}}, now: time.Date(2026, 1, 1, 8, 59, 0, 0, jst), next: time.Date(2026, 1, 1, 9, 0, 0, 0, jst)},
+		{job: &schedule.Job{At: "09:00", Args: []string{"apod", "post"}}, now: time.Date(2026, 1, 1, 9, 0, 0, 0, jst), next: time.Date(2026, 1, 2, 9, 0, 0, 0, jst)},
+		{job: &schedule.Job{At: "09:00", Args: []string{"apod", "post"}}, now: time.Date(2026, 12, 31, 10, 0, 0, 0, jst), next: time.Date(2027, 1, 1, 9, 0, 0, 0, jst)},
+		{job: &schedule.Job{Day: 1, At: "00:05", Args: []string{"calendar", "post"}}, now: time.Date(2026, 1, 1, 0, 0, 0, 0, jst), next: time.Date(2026, 1, 1, 0, 5, 0, 0, jst)},
+		{job: &schedule.Job{Day: 1, At: "00:05", Args: []string{"calendar", "post"}}, now: time.Date(2026, 1, 15, 0, 0, 0, 0, jst), next: time.Date(2026, 2, 1, 0, 5, 0, 0, jst)},
+		{job: &schedule.Job{Day: 31, At: "12:00", Args: []string{"calendar", "post"}}, now: time.Date(2026, 1, 31, 13, 0, 0, 0, jst), next: time.Date(2026, 2, 28, 12, 0, 0, 0, jst)},
+	}
+	for _, tc := range testCases {
+		if _, err := schedule.New(&schedule.Config{Jobs: []*schedule.Job{tc.job}}, nil); err != nil {
+			t.Errorf("New() error = \"%+v\", want <nil>.", err)
+			continue
+		}
+		if next := tc.job.Next(tc.now); !next.Equal(tc.next) {
+			t.Errorf("Job(%v).Next(%v) = %v, want %v.", tc.job, tc.now, next, tc.next)
+		}
+	}
+}
+
+func TestNewError(t *testing.T) {
+	testCases := []struct {
+		cfg *schedule.Config
+	}{
+		{cfg: nil},
+		{cfg: &schedule.Config{}},
+		{cfg: &schedule.Config{Jobs: []*schedule.Job{{Every: time.Minute}}}},
+		{cfg: &schedule.Config{Jobs: []*schedule.Job{{Args: []string{"feed", "post"}}}}},
+		{cfg: &schedule.Config{Jobs: []*schedule.Job{{At: "25:00", Args: []string{"apod", "post"}}}}},
+		{cfg: &schedule.Config{Jobs: []*schedule.Job{{Every: time.Minute, At: "09:00", Args: []string{"apod", "post"}}}}},
+		{cfg: &schedule.Config{Jobs: []*schedule.Job{{Every: time.Minute, Args: []string{"serve"}}}}},
+		{cfg: &schedule.Config{TimeZone: "Unknown/Zone", Jobs: []*schedule.Job{{Every: time.Minute, Args: []string{"feed", "post"}}}}},
+	}
+	for _, tc := range testCases {
+		if _, err := schedule.New(tc.cfg, nil); !errors.Is(err, ecode.ErrInvalidSchedule) {
+			t.Errorf("New(%+v) error = \"%+v\", want \"%+v\".", tc.cfg, err, ecode.ErrInvalidSchedule)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
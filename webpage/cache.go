@@ -1,29 +1,77 @@
 package webpage
 
 import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
+
+	"github.com/goark/errs"
+)
+
+const (
+	// cacheFileName is name of cache file in cache directory.
+	cacheFileName = "webpage-cache.json"
+	// DefaultCacheTTL is default TTL of Web page data in cache.
+	DefaultCacheTTL = 7 * 24 * time.Hour
 )
 
 // Cache is cache data for Web page informations.
 type Cache struct {
-	mu sync.RWMutex
-	// dir   string
+	mu    sync.RWMutex
+	dir   string
+	ttl   time.Duration
 	pages map[string]*Webpage
 }
 
 // NewCache function creates Cache instance and import cache data.
+// If cache file in dir is broken, it is ignored.
 func NewCache(dir string) *Cache {
-	return &Cache{pages: map[string]*Webpage{}}
+	c := &Cache{dir: dir, ttl: DefaultCacheTTL, pages: map[string]*Webpage{}}
+	if len(dir) == 0 {
+		return c
+	}
+	b, err := os.ReadFile(filepath.Join(dir, cacheFileName))
+	if err != nil {
+		return c
+	}
+	pages := map[string]*Webpage{}
+	if err := json.Unmarshal(b, &pages); err != nil {
+		return c
+	}
+	for urlStr, page := range pages {
+		if page != nil && !page.Expired(c.ttl) {
+			c.pages[urlStr] = page
+		}
+	}
+	return c
+}
+
+// SetTTL method sets TTL of Web page data in cache (0 is no expiration).
+func (c *Cache) SetTTL(ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
 }
 
-// Get method gets Web page data from cache.
+// Get method gets Web page data from cache. Expired data is not returned.
 func (c *Cache) Get(urlStr string) *Webpage {
 	if c == nil {
 		return nil
 	}
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.pages[urlStr]
+	page := c.pages[urlStr]
+	if page.Expired(c.ttl) {
+		return nil
+	}
+	return page
 }
 
 // Put method puts Web page data to cache.
@@ -41,6 +89,38 @@ func (c *Cache) Put(page *Webpage) {
 	}
 }
 
+// Save method saves Web page data (except expired data) to cache file.
+func (c *Cache) Save() error {
+	if c == nil || len(c.dir) == 0 {
+		return nil
+	}
+	c.mu.RLock()
+	pages := map[string]*Webpage{}
+	for urlStr, page := range c.pages {
+		if page != nil && page.FetchedAt != nil && !page.Expired(c.ttl) {
+			pages[urlStr] = page
+		}
+	}
+	c.mu.RUnlock()
+
+	b, err := json.Marshal(pages)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil && !errors.Is(err, fs.ErrExist) {
+		return errs.Wrap(err, errs.WithContext("dir", c.dir))
+	}
+	path := filepath.Join(c.dir, cacheFileName)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", tmp))
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return nil
+}
+
 /* Copyright 2023 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,99 @@
+package webpage_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/webpage"
+)
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	old := now.Add(-30 * 24 * time.Hour)
+
+	c := webpage.NewCache(dir)
+	c.Put(&webpage.Webpage{URL: "https://example.com/fresh", Title: "fresh", FetchedAt: &now})
+	c.Put(&webpage.Webpage{URL: "https://example.com/old", Title: "old", FetchedAt: &old})
+	if err := c.Save(); err != nil {
+		t.Fatalf("Cache.Save() error = \"%+v\", want nil.", err)
+	}
+
+	testCases := []struct {
+		url   string
+		ttl   time.Duration
+		found bool
+	}{
+		{url: "https://example.com/fresh", ttl: webpage.DefaultCacheTTL, found: true},
+		{url: "https://example.com/old", ttl: webpage.DefaultCacheTTL, found: false},
+		{url: "https://example.com/old", ttl: 0, found: true},
+		{url: "https://example.com/none", ttl: 0, found: false},
+	}
+	for _, tc := range testCases {
+		c.SetTTL(tc.ttl)
+		if page := c.Get(tc.url); (page != nil) != tc.found {
+			t.Errorf("Cache.Get(%v) (ttl %v) = %v, want found %v.", tc.url, tc.ttl, page, tc.found)
+		}
+	}
+
+	// reload cache file (expired data is not saved)
+	c = webpage.NewCache(dir)
+	c.SetTTL(0)
+	if page := c.Get("https://example.com/fresh"); page == nil || page.Title != "fresh" {
+		t.Errorf("Cache.Get() after reloading = %v, want %v.", page, "fresh")
+	}
+	if page := c.Get("https://example.com/old"); page != nil {
+		t.Errorf("Cache.Get() after reloading = %v, want nil.", page)
+	}
+}
+
+func TestSaveWebpageTwice(t *testing.T) {
+	ctx := context.Background()
+	dir := testDir
+	cfg, err := webpage.New(ctx, dir, logger.Nop())
+	if err != nil {
+		t.Fatalf("New() error = \"%+v\", want nil.", err)
+	}
+	const urlStr = "https://example.com/page"
+	for _, title := range []string{"first", "second"} {
+		now := time.Now()
+		if err := cfg.Save(ctx, []*webpage.Webpage{{URL: urlStr, Title: title, FetchedAt: &now}}); err != nil {
+			t.Fatalf("Config.Save(%v) error = \"%+v\", want nil.", title, err)
+		}
+	}
+
+	// find data in database (without cache file)
+	if err := os.Remove(filepath.Join(dir, "webpage-cache.json")); err != nil {
+		t.Fatalf("os.Remove() error = \"%+v\", want nil.", err)
+	}
+	cfg, err = webpage.New(ctx, dir, logger.Nop())
+	if err != nil {
+		t.Fatalf("New() error = \"%+v\", want nil.", err)
+	}
+	page, exist, err := cfg.GetWebpage(ctx, urlStr)
+	if err != nil {
+		t.Fatalf("Config.GetWebpage() error = \"%+v\", want nil.", err)
+	}
+	if !exist || page.Title != "second" || page.FetchedAt == nil {
+		t.Errorf("Config.GetWebpage() = %+v (exist %v), want title %v.", page, exist, "second")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -16,17 +16,20 @@ func (cfg *Config) CreatePool() {
 	}
 }
 
-// StopPool creates pool and starts goroutine,
+// StopPool stops pool, moves watermarks of feeds and returns web pages in pool.
 func (cfg *Config) StopPool() ([]*Webpage, error) {
 	if cfg == nil || cfg.itemPool == nil {
 		return nil, errs.Wrap(ecode.ErrNullPointer)
 	}
 	cfg.itemPool.done()
+	// move watermarks of feeds except failed items
+	cfg.feedStates.settle(cfg.itemPool.failedItems())
+	// get pages from pool (pages are returned with error, because watermarks are moved past them)
+	pages := cfg.GetPagesFromPool()
 	if err := cfg.GetErrorInPool(); err != nil {
-		return nil, errs.Wrap(err)
+		return pages, errs.Wrap(err)
 	}
-	// get pages from pool
-	return cfg.GetPagesFromPool(), nil
+	return pages, nil
 }
 
 func (cfg *Config) GetErrorInPool() error {
@@ -2,21 +2,29 @@ package webpage
 
 import (
 	"context"
+	"time"
 
 	"github.com/goark/errs"
 	"github.com/goark/toolbox/db"
 	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/webpage/feed"
 	"github.com/ipfs/go-log/v2"
 	"go.uber.org/zap"
 )
 
 // Config is configuration for webpage
 type Config struct {
-	cacheDir  string
-	cacheData *Cache
-	itemPool  *itemPool
-	logger    *log.ZapEventLogger
-	repos     *db.Repository
+	cacheDir   string
+	cacheData  *Cache
+	cacheTTL   time.Duration
+	itemPool   *itemPool
+	feedStates *feedStates
+	catchUp    bool
+	refresh    bool
+	filter     *feed.Filter
+	dropped    *droppedItems
+	logger     *log.ZapEventLogger
+	repos      *db.Repository
 }
 
 // New functions creates new Config instance.
@@ -26,15 +34,23 @@ func New(ctx context.Context, cacheDir string, logger *log.ZapEventLogger) (*Con
 	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("cache_dir", cacheDir))
 	}
+	return NewWithRepository(cacheDir, repos, logger), nil
+}
+
+// NewWithRepository functions creates new Config instance with opened database.
+func NewWithRepository(cacheDir string, repos *db.Repository, logger *log.ZapEventLogger) *Config {
 	// make configuration
 	cfg := &Config{
-		cacheDir:  cacheDir,
-		cacheData: NewCache(cacheDir),
-		logger:    logger,
-		repos:     repos,
+		cacheDir:   cacheDir,
+		cacheData:  NewCache(cacheDir),
+		cacheTTL:   DefaultCacheTTL,
+		feedStates: newFeedStates(),
+		dropped:    &droppedItems{},
+		logger:     logger,
+		repos:      repos,
 	}
 	cfg.CreatePool()
-	return cfg, nil
+	return cfg
 }
 
 // Logger method returns zap.Logger instance.
@@ -45,12 +61,37 @@ func (cfg *Config) Logger() *zap.Logger {
 	return cfg.logger.Desugar()
 }
 
+// SetCacheTTL method sets TTL of web page data (0 is no expiration).
+func (cfg *Config) SetCacheTTL(ttl time.Duration) {
+	if cfg == nil {
+		return
+	}
+	cfg.cacheData.SetTTL(ttl)
+	cfg.cacheTTL = ttl
+}
+
+// SetRefresh method sets refresh mode. In refresh mode, web page data older than TTL is fetched again.
+func (cfg *Config) SetRefresh(refresh bool) {
+	if cfg == nil {
+		return
+	}
+	cfg.refresh = refresh
+}
+
+// Save method saves web pages and states of fetched feeds to database, and saves cache file.
 func (cfg *Config) Save(ctx context.Context, list []*Webpage) error {
+	if err := cfg.saveFeedStates(ctx); err != nil {
+		return errs.Wrap(err)
+	}
 	if len(list) == 0 {
 		cfg.Logger().Debug("no save data in pool")
-		return nil
+	} else if err := cfg.saveDB(ctx, list); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := cfg.cacheData.Save(); err != nil {
+		return errs.Wrap(err)
 	}
-	return cfg.saveDB(ctx, list)
+	return nil
 }
 
 /* Copyright 2023 Spiegel
@@ -0,0 +1,142 @@
+package webpage
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+// FeedCandidate is candidate of feed found by DiscoverFeeds function.
+type FeedCandidate struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+	Type  string `json:"type,omitempty"`  // MIME type in link element, or feed type (rss, atom or json) if probed
+	Found string `json:"found,omitempty"` // "link" (link element in web page) or "probe" (common path)
+}
+
+// feedMIMETypes is list of MIME types for feed in link element.
+var feedMIMETypes = []string{
+	"application/rss+xml",
+	"application/atom+xml",
+	"application/feed+json",
+}
+
+// feedCommonPaths is list of common paths of feed in web site.
+var feedCommonPaths = []string{
+	"/feed",
+	"/feed/",
+	"/rss",
+	"/rss.xml",
+	"/feed.xml",
+	"/atom.xml",
+	"/index.xml",
+	"/index.rss",
+	"/feed.json",
+}
+
+// DiscoverFeeds function returns candidates of feed for web page URL.
+// Feeds are looked for in link elements (rel="alternate") of the web page first.
+// If no link element is found, the URL itself and common paths in the web site are probed.
+func DiscoverFeeds(ctx context.Context, urlStr string) ([]*FeedCandidate, error) {
+	doc, base, err := readDocument(ctx, urlStr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	candidates := feedLinks(doc, base)
+	if len(candidates) > 0 {
+		return candidates, nil
+	}
+
+	// probe feeds
+	if c := probeFeed(ctx, base); c != nil {
+		return []*FeedCandidate{c}, nil
+	}
+	for _, p := range feedCommonPaths {
+		u, err := base.Parse(p)
+		if err != nil {
+			continue
+		}
+		if c := probeFeed(ctx, u); c != nil && !containsCandidate(candidates, c.URL) {
+			candidates = append(candidates, c)
+		}
+	}
+	return candidates, nil
+}
+
+// feedLinks function returns feeds in link elements (rel="alternate") of HTML document.
+func feedLinks(doc *goquery.Document, base *url.URL) []*FeedCandidate {
+	candidates := []*FeedCandidate{}
+	doc.Find("link[href]").Each(func(_ int, s *goquery.Selection) {
+		if !hasToken(s.AttrOr("rel", ""), "alternate") {
+			return
+		}
+		typ := strings.ToLower(strings.TrimSpace(s.AttrOr("type", "")))
+		if !contains(feedMIMETypes, typ) {
+			return
+		}
+		u, err := base.Parse(strings.TrimSpace(s.AttrOr("href", "")))
+		if err != nil {
+			return
+		}
+		if containsCandidate(candidates, u.String()) {
+			return
+		}
+		candidates = append(candidates, &FeedCandidate{URL: u.String(), Title: strings.TrimSpace(s.AttrOr("title", "")), Type: typ, Found: "link"})
+	})
+	return candidates
+}
+
+// probeFeed function returns candidate if URL is available feed (nil if not).
+func probeFeed(ctx context.Context, u *url.URL) *FeedCandidate {
+	data, _, err := feed.FeedConditional(ctx, u, nil)
+	if err != nil {
+		return nil
+	}
+	return &FeedCandidate{URL: u.String(), Title: data.Title, Type: data.FeedType, Found: "probe"}
+}
+
+func hasToken(s, token string) bool {
+	for _, t := range strings.Fields(s) {
+		if strings.EqualFold(t, token) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCandidate(list []*FeedCandidate, urlStr string) bool {
+	for _, c := range list {
+		if c.URL == urlStr {
+			return true
+		}
+	}
+	return false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,78 @@
+package webpage_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goark/toolbox/webpage"
+)
+
+const testDiscoverRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"><channel><title>probed</title><link>https://example.com/</link>
+<item><title>item 1</title><link>https://example.com/1</link></item>
+</channel></rss>`
+
+func TestDiscoverFeeds(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/with-link", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><title>test</title>
+<link rel="alternate" type="application/rss+xml" title="RSS" href="/rss.xml">
+<link rel="alternate" type="application/feed+json" title="JSON" href="feed.json">
+<link rel="alternate" type="text/html" href="/other">
+<link rel="stylesheet" type="application/rss+xml" href="/style">
+</head><body></body></html>`))
+	})
+	mux.HandleFunc("/without-link", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><title>test</title></head><body></body></html>`))
+	})
+	mux.HandleFunc("/atom.xml", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testDiscoverRSS))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	testCases := []struct {
+		path  string
+		urls  []string
+		found string
+	}{
+		{path: "/with-link", urls: []string{server.URL + "/rss.xml", server.URL + "/feed.json"}, found: "link"},
+		{path: "/without-link", urls: []string{server.URL + "/atom.xml"}, found: "probe"},
+		{path: "/atom.xml", urls: []string{server.URL + "/atom.xml"}, found: "probe"},
+	}
+	for _, tc := range testCases {
+		candidates, err := webpage.DiscoverFeeds(context.Background(), server.URL+tc.path)
+		if err != nil {
+			t.Errorf("DiscoverFeeds(%v) error = \"%+v\", want nil.", tc.path, err)
+			continue
+		}
+		if len(candidates) != len(tc.urls) {
+			t.Errorf("count of DiscoverFeeds(%v) = %v, want %v.", tc.path, len(candidates), len(tc.urls))
+			continue
+		}
+		for i, c := range candidates {
+			if c.URL != tc.urls[i] || c.Found != tc.found {
+				t.Errorf("DiscoverFeeds(%v)[%d] = %+v, want url=%v found=%v.", tc.path, i, c, tc.urls[i], tc.found)
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,92 @@
+package webpage
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/goark/errs"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+type feedListFormat int
+
+const (
+	feedListText feedListFormat = iota
+	feedListOPML
+	feedListYAML
+	feedListTOML
+)
+
+// feedListFormatOf function returns format of feed list file by extension.
+func feedListFormatOf(path string) feedListFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".opml", ".xml":
+		return feedListOPML
+	case ".yaml", ".yml":
+		return feedListYAML
+	case ".toml":
+		return feedListTOML
+	default:
+		return feedListText
+	}
+}
+
+// feedListFile is structure of structured (YAML or TOML) feed list file.
+type feedListFile struct {
+	Feeds FeedList `yaml:"feeds" toml:"feeds"`
+}
+
+// DecodeFeedListYAML function decodes YAML feed list.
+func DecodeFeedListYAML(r io.Reader) (FeedList, error) {
+	var data feedListFile
+	if err := yaml.NewDecoder(r).Decode(&data); err != nil && !errs.Is(err, io.EOF) {
+		return FeedList{}, errs.Wrap(err)
+	}
+	fl, _ := FeedList{}.Merge(data.Feeds)
+	return fl, nil
+}
+
+// DecodeFeedListTOML function decodes TOML feed list.
+func DecodeFeedListTOML(r io.Reader) (FeedList, error) {
+	var data feedListFile
+	if err := toml.NewDecoder(r).Decode(&data); err != nil {
+		return FeedList{}, errs.Wrap(err)
+	}
+	fl, _ := FeedList{}.Merge(data.Feeds)
+	return fl, nil
+}
+
+// EncodeYAML method encodes FeedList to YAML format.
+func (fl FeedList) EncodeYAML(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&feedListFile{Feeds: fl}); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(enc.Close())
+}
+
+// EncodeTOML method encodes FeedList to TOML format.
+func (fl FeedList) EncodeTOML(w io.Writer) error {
+	if err := toml.NewEncoder(w).Encode(&feedListFile{Feeds: fl}); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -3,6 +3,8 @@ package webpage
 import (
 	"bufio"
 	"context"
+	"fmt"
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -10,13 +12,110 @@ import (
 	"github.com/goark/errs"
 	"github.com/goark/errs/zapobject"
 	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/webpage/feed"
 	"go.uber.org/zap"
 )
 
-// FeedList is list of feed URLs.
-type FeedList []string
+// FeedEntry is entry of feed in feed list. Options except URL, Title and Tags are available in structured (YAML or TOML) feed list.
+type FeedEntry struct {
+	URL          string       `json:"url" yaml:"url" toml:"url"`
+	Title        string       `json:"title,omitempty" yaml:"title,omitempty" toml:"title,omitempty"`
+	Tags         []string     `json:"tags,omitempty" yaml:"tags,omitempty" toml:"tags,omitempty"`
+	Prefix       string       `json:"prefix,omitempty" yaml:"prefix,omitempty" toml:"prefix,omitempty"`                   // prefix text of message (instead of --prefix-text flag)
+	Destinations []string     `json:"destinations,omitempty" yaml:"destinations,omitempty" toml:"destinations,omitempty"` // posting destinations (instead of --bluesky and --mastodon flags)
+	WithImage    *bool        `json:"with_image,omitempty" yaml:"with_image,omitempty" toml:"with_image,omitempty"`       // post with image (instead of --with-image flag)
+	Visibility   string       `json:"visibility,omitempty" yaml:"visibility,omitempty" toml:"visibility,omitempty"`       // visibility for Mastodon
+	Include      []string     `json:"include,omitempty" yaml:"include,omitempty" toml:"include,omitempty"`                // keywords which items must contain one of
+	Exclude      []string     `json:"exclude,omitempty" yaml:"exclude,omitempty" toml:"exclude,omitempty"`                // keywords which items must not contain
+	MaxItems     int          `json:"max_items,omitempty" yaml:"max_items,omitempty" toml:"max_items,omitempty"`          // maximum number of new items per run (0 is unlimited)
+	Filters      []*feed.Rule `json:"filters,omitempty" yaml:"filters,omitempty" toml:"filters,omitempty"`                // filtering rules for items
+}
+
+// HasTag method returns true if FeedEntry has the tag (case-insensitive).
+func (fe *FeedEntry) HasTag(tag string) bool {
+	if fe == nil {
+		return false
+	}
+	for _, t := range fe.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// addTags method adds tags to FeedEntry without duplication.
+func (fe *FeedEntry) addTags(tags ...string) {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if len(tag) > 0 && !fe.HasTag(tag) {
+			fe.Tags = append(fe.Tags, tag)
+		}
+	}
+}
+
+// merge method merges options of other FeedEntry with the same URL.
+// Single-value options keep current values if set, and list options are joined.
+func (fe *FeedEntry) merge(other *FeedEntry) {
+	if len(fe.Title) == 0 {
+		fe.Title = other.Title
+	}
+	fe.addTags(other.Tags...)
+	if len(fe.Prefix) == 0 {
+		fe.Prefix = other.Prefix
+	}
+	for _, name := range other.Destinations {
+		if !containsFold(fe.Destinations, name) {
+			fe.Destinations = append(fe.Destinations, name)
+		}
+	}
+	if fe.WithImage == nil {
+		fe.WithImage = other.WithImage
+	}
+	if len(fe.Visibility) == 0 {
+		fe.Visibility = other.Visibility
+	}
+	fe.Include = append(fe.Include, other.Include...)
+	fe.Exclude = append(fe.Exclude, other.Exclude...)
+	if fe.MaxItems == 0 {
+		fe.MaxItems = other.MaxItems
+	}
+	fe.Filters = append(fe.Filters, other.Filters...)
+}
+
+// filter method returns Filter instance compiled from filtering rules (include and exclude keywords are rules for title and description).
+func (fe *FeedEntry) filter() (*feed.Filter, error) {
+	if fe == nil {
+		return nil, nil
+	}
+	rules := append([]*feed.Rule{}, fe.Filters...)
+	if len(fe.Include) > 0 {
+		rules = append(rules, &feed.Rule{Name: "include", Action: feed.ActionInclude, Keywords: fe.Include})
+	}
+	if len(fe.Exclude) > 0 {
+		rules = append(rules, &feed.Rule{Name: "exclude", Action: feed.ActionExclude, Keywords: fe.Exclude})
+	}
+	f, err := feed.NewFilter(rules)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("feed_url", fe.URL))
+	}
+	return f, nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, e := range list {
+		if strings.EqualFold(e, s) {
+			return true
+		}
+	}
+	return false
+}
 
-// NewFeedList function returns new instance of FeedList.
+// FeedList is list of feeds.
+type FeedList []*FeedEntry
+
+// NewFeedList function returns new instance of FeedList from file.
+// The file is OPML (extension .opml or .xml), YAML (.yaml or .yml), TOML (.toml) or plain text (one URL and optional tags per line).
 func NewFeedList(path string) (FeedList, error) {
 	file, err := os.Open(path)
 	if err != nil {
@@ -24,20 +123,127 @@ func NewFeedList(path string) (FeedList, error) {
 	}
 	defer file.Close()
 
+	var fl FeedList
+	switch feedListFormatOf(path) {
+	case feedListOPML:
+		fl, err = DecodeOPML(file)
+	case feedListYAML:
+		fl, err = DecodeFeedListYAML(file)
+	case feedListTOML:
+		fl, err = DecodeFeedListTOML(file)
+	default:
+		fl, err = DecodeFeedListText(file)
+	}
+	if err != nil {
+		return FeedList{}, errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return fl, nil
+}
+
+// DecodeFeedListText function decodes plain text feed list.
+// Each line has feed URL and optional tags separated by white spaces. Lines starting with '#' are comments.
+func DecodeFeedListText(r io.Reader) (FeedList, error) {
 	list := FeedList{}
-	s := bufio.NewScanner(file)
+	s := bufio.NewScanner(r)
 	for s.Scan() {
-		txt := strings.TrimSpace(s.Text())
-		if len(txt) > 0 {
-			list = append(list, txt)
+		fields := strings.Fields(s.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
 		}
+		entry := &FeedEntry{URL: fields[0]}
+		entry.addTags(fields[1:]...)
+		list = list.add(entry)
 	}
 	if err := s.Err(); err != nil {
-		return FeedList{}, errs.Wrap(err, errs.WithContext("path", path))
+		return FeedList{}, errs.Wrap(err)
 	}
 	return list, nil
 }
 
+// EncodeText method encodes FeedList to plain text format.
+func (fl FeedList) EncodeText(w io.Writer) error {
+	for _, entry := range fl {
+		line := strings.Join(append([]string{entry.URL}, entry.Tags...), " ")
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// Save method writes FeedList to file. The format is selected by extension of the file (see NewFeedList function).
+func (fl FeedList) Save(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	defer file.Close()
+
+	switch feedListFormatOf(path) {
+	case feedListOPML:
+		err = fl.EncodeOPML(file, "")
+	case feedListYAML:
+		err = fl.EncodeYAML(file)
+	case feedListTOML:
+		err = fl.EncodeTOML(file)
+	default:
+		err = fl.EncodeText(file)
+	}
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("path", path))
+	}
+	return nil
+}
+
+// Filter method returns feeds which have the tag (group). If tag is empty, returns all feeds.
+func (fl FeedList) Filter(tag string) FeedList {
+	if len(tag) == 0 {
+		return fl
+	}
+	list := FeedList{}
+	for _, entry := range fl {
+		if entry.HasTag(tag) {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// Merge method merges other FeedList, and returns merged list and number of added feeds.
+// Tags and options of feeds with the same URL are merged (single-value options of the first feed take precedence).
+func (fl FeedList) Merge(other FeedList) (FeedList, int) {
+	count := 0
+	for _, entry := range other {
+		if fl.find(entry.URL) == nil {
+			count++
+		}
+		fl = fl.add(entry)
+	}
+	return fl, count
+}
+
+func (fl FeedList) find(urlStr string) *FeedEntry {
+	for _, entry := range fl {
+		if entry.URL == urlStr {
+			return entry
+		}
+	}
+	return nil
+}
+
+func (fl FeedList) add(entry *FeedEntry) FeedList {
+	if entry == nil || len(entry.URL) == 0 {
+		return fl
+	}
+	if current := fl.find(entry.URL); current != nil {
+		current.merge(entry)
+		return fl
+	}
+	cpy := &FeedEntry{URL: entry.URL}
+	cpy.merge(entry)
+	return append(fl, cpy)
+}
+
 // Parse method parses feeds.
 func (fl FeedList) Parse(ctx context.Context, cfg *Config) error {
 	if cfg == nil {
@@ -48,12 +254,13 @@ func (fl FeedList) Parse(ctx context.Context, cfg *Config) error {
 	}
 	var wg sync.WaitGroup
 	errList := &errs.Errors{}
-	for _, urlStr := range fl {
-		urlStr := urlStr
+	for _, entry := range fl {
+		entry := entry
+		urlStr := entry.URL
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if err := cfg.Feed(ctx, urlStr); err != nil {
+			if err := cfg.FeedEntry(ctx, entry); err != nil {
 				errList.Add(errs.Wrap(err, errs.WithContext("feed_url", urlStr)))
 			}
 		}()
@@ -0,0 +1,196 @@
+package webpage_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/webpage"
+)
+
+const testOPML = `<?xml version="1.0" encoding="UTF-8"?>
+<opml version="2.0">
+  <head><title>subscriptions</title></head>
+  <body>
+    <outline text="astro" title="astro">
+      <outline text="APOD" type="rss" xmlUrl="https://example.com/apod.rss" htmlUrl="https://example.com/"/>
+      <outline text="news">
+        <outline text="Sky News" type="rss" xmlUrl="https://example.com/sky.rss" category="/space,/science"/>
+      </outline>
+    </outline>
+    <outline text="Blog" type="rss" xmlUrl="https://example.com/blog.rss"/>
+  </body>
+</opml>`
+
+func TestDecodeOPML(t *testing.T) {
+	fl, err := webpage.DecodeOPML(strings.NewReader(testOPML))
+	if err != nil {
+		t.Fatalf("DecodeOPML() error = \"%+v\", want <nil>.", err)
+	}
+	testCases := []struct {
+		url   string
+		title string
+		tags  string
+	}{
+		{url: "https://example.com/apod.rss", title: "APOD", tags: "astro"},
+		{url: "https://example.com/sky.rss", title: "Sky News", tags: "astro news space science"},
+		{url: "https://example.com/blog.rss", title: "Blog", tags: ""},
+	}
+	if len(fl) != len(testCases) {
+		t.Fatalf("DecodeOPML() = %v feeds, want %v.", len(fl), len(testCases))
+	}
+	for i, tc := range testCases {
+		if fl[i].URL != tc.url || fl[i].Title != tc.title || strings.Join(fl[i].Tags, " ") != tc.tags {
+			t.Errorf("DecodeOPML()[%d] = %+v, want %+v.", i, fl[i], tc)
+		}
+	}
+
+	// round trip
+	buf := &bytes.Buffer{}
+	if err := fl.EncodeOPML(buf, ""); err != nil {
+		t.Fatalf("FeedList.EncodeOPML() error = \"%+v\", want <nil>.", err)
+	}
+	fl2, err := webpage.DecodeOPML(buf)
+	if err != nil {
+		t.Fatalf("DecodeOPML() error = \"%+v\", want <nil>.", err)
+	}
+	for i, tc := range testCases {
+		if fl2[i].URL != tc.url || strings.Join(fl2[i].Tags, " ") != tc.tags {
+			t.Errorf("DecodeOPML(EncodeOPML())[%d] = %+v, want %+v.", i, fl2[i], tc)
+		}
+	}
+	if got := len(fl.Filter("ASTRO")); got != 2 {
+		t.Errorf("FeedList.Filter() = %v feeds, want %v.", got, 2)
+	}
+}
+
+func TestDecodeFeedListText(t *testing.T) {
+	fl, err := webpage.DecodeFeedListText(strings.NewReader("# comment\nhttps://example.com/a.rss astro news\n\nhttps://example.com/b.rss\nhttps://example.com/a.rss space\n"))
+	if err != nil {
+		t.Fatalf("DecodeFeedListText() error = \"%+v\", want <nil>.", err)
+	}
+	if len(fl) != 2 || strings.Join(fl[0].Tags, " ") != "astro news space" || len(fl[1].Tags) != 0 {
+		t.Errorf("DecodeFeedListText() = %+v, want 2 feeds.", fl)
+	}
+	merged, count := fl.Merge(webpage.FeedList{{URL: "https://example.com/b.rss", Tags: []string{"blog"}}, {URL: "https://example.com/c.rss"}})
+	if count != 1 || len(merged) != 3 || !merged[1].HasTag("blog") {
+		t.Errorf("FeedList.Merge() = %+v (%v added), want 3 feeds (1 added).", merged, count)
+	}
+	buf := &bytes.Buffer{}
+	if err := merged.EncodeText(buf); err != nil {
+		t.Fatalf("FeedList.EncodeText() error = \"%+v\", want <nil>.", err)
+	}
+	want := "https://example.com/a.rss astro news space\nhttps://example.com/b.rss blog\nhttps://example.com/c.rss\n"
+	if buf.String() != want {
+		t.Errorf("FeedList.EncodeText() = %q, want %q.", buf.String(), want)
+	}
+}
+
+func TestFeedListMergeDuplicate(t *testing.T) {
+	yamlText := `feeds:
+  - url: https://example.com/apod.rss
+    tags: [astro]
+    prefix: "[APOD]"
+    include: [galaxy]
+  - url: https://example.com/apod.rss
+    tags: [space]
+    prefix: "[Space]"
+    destinations: [mastodon]
+    with_image: false
+    include: [nebula]
+    max_items: 2
+`
+	fl, err := webpage.DecodeFeedListYAML(strings.NewReader(yamlText))
+	if err != nil {
+		t.Fatalf("DecodeFeedListYAML() error = \"%+v\", want <nil>.", err)
+	}
+	if len(fl) != 1 {
+		t.Fatalf("DecodeFeedListYAML() = %v feeds, want %v.", len(fl), 1)
+	}
+	e := fl[0]
+	if e.Prefix != "[APOD]" || strings.Join(e.Tags, ",") != "astro,space" || strings.Join(e.Destinations, ",") != "mastodon" || e.WithImage == nil || *e.WithImage ||
+		strings.Join(e.Include, ",") != "galaxy,nebula" || e.MaxItems != 2 {
+		t.Errorf("DecodeFeedListYAML()[0] = %+v, want merged options.", e)
+	}
+}
+
+func TestDecodeFeedListStructured(t *testing.T) {
+	yamlText := `feeds:
+  - url: https://example.com/apod.rss
+    tags: [astro]
+    prefix: "[APOD]"
+    destinations: [bluesky]
+    with_image: true
+    visibility: unlisted
+    include: [galaxy, nebula]
+    exclude: [ad]
+    max_items: 3
+  - url: https://example.com/blog.rss
+`
+	tomlText := `[[feeds]]
+url = "https://example.com/apod.rss"
+tags = ["astro"]
+prefix = "[APOD]"
+destinations = ["bluesky"]
+with_image = true
+visibility = "unlisted"
+include = ["galaxy", "nebula"]
+exclude = ["ad"]
+max_items = 3
+
+[[feeds]]
+url = "https://example.com/blog.rss"
+`
+	testCases := []struct {
+		name   string
+		decode func() (webpage.FeedList, error)
+	}{
+		{name: "YAML", decode: func() (webpage.FeedList, error) { return webpage.DecodeFeedListYAML(strings.NewReader(yamlText)) }},
+		{name: "TOML", decode: func() (webpage.FeedList, error) { return webpage.DecodeFeedListTOML(strings.NewReader(tomlText)) }},
+	}
+	for _, tc := range testCases {
+		fl, err := tc.decode()
+		if err != nil {
+			t.Errorf("DecodeFeedList%s() error = \"%+v\", want <nil>.", tc.name, err)
+			continue
+		}
+		if len(fl) != 2 {
+			t.Errorf("DecodeFeedList%s() = %v feeds, want %v.", tc.name, len(fl), 2)
+			continue
+		}
+		e := fl[0]
+		if e.Prefix != "[APOD]" || strings.Join(e.Destinations, ",") != "bluesky" || e.WithImage == nil || !*e.WithImage || e.Visibility != "unlisted" ||
+			strings.Join(e.Include, ",") != "galaxy,nebula" || strings.Join(e.Exclude, ",") != "ad" || e.MaxItems != 3 || !e.HasTag("astro") {
+			t.Errorf("DecodeFeedList%s()[0] = %+v, want all options.", tc.name, e)
+		}
+		if fl[1].WithImage != nil || fl[1].MaxItems != 0 {
+			t.Errorf("DecodeFeedList%s()[1] = %+v, want no options.", tc.name, fl[1])
+		}
+	}
+
+	// round trip
+	fl, _ := webpage.DecodeFeedListYAML(strings.NewReader(yamlText))
+	buf := &bytes.Buffer{}
+	if err := fl.EncodeTOML(buf); err != nil {
+		t.Fatalf("FeedList.EncodeTOML() error = \"%+v\", want <nil>.", err)
+	}
+	fl2, err := webpage.DecodeFeedListTOML(buf)
+	if err != nil || len(fl2) != 2 || fl2[0].MaxItems != 3 || fl2[0].Prefix != "[APOD]" {
+		t.Errorf("DecodeFeedListTOML(EncodeTOML()) = %+v, %v, want same as YAML.", fl2, err)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,164 @@
+package webpage
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/errs/zapobject"
+	"github.com/goark/toolbox/db/model"
+	"github.com/goark/toolbox/webpage/feed"
+	"go.uber.org/zap"
+)
+
+// feedStates is states of fetched feeds, saved to database by Config.Save method.
+type feedStates struct {
+	mu      sync.Mutex
+	states  map[string]*model.Feed
+	pending map[string]*pendingItems
+}
+
+// pendingItems is items in feed waiting for results of item pool to move watermark.
+type pendingItems struct {
+	items    []*feed.Item
+	deferred []*feed.Item
+}
+
+func newFeedStates() *feedStates {
+	return &feedStates{states: map[string]*model.Feed{}, pending: map[string]*pendingItems{}}
+}
+
+// putPending method puts state of feed whose watermark is moved after item pool is stopped (see settle method).
+func (fs *feedStates) putPending(state *model.Feed, items, deferred []*feed.Item) {
+	if fs == nil || state == nil {
+		return
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.states[state.URL] = state
+	fs.pending[state.URL] = &pendingItems{items: items, deferred: deferred}
+}
+
+// settle method moves watermarks of pending states.
+// Watermark is not moved past failed items (and deferred items), so these items are retried in next run.
+func (fs *feedStates) settle(failed []*feed.Item) {
+	if fs == nil {
+		return
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	isFailed := map[*feed.Item]bool{}
+	for _, item := range failed {
+		isFailed[item] = true
+	}
+	for urlStr, p := range fs.pending {
+		held := append([]*feed.Item{}, p.deferred...)
+		for _, item := range p.items {
+			if isFailed[item] {
+				held = append(held, item)
+			}
+		}
+		if state, ok := fs.states[urlStr]; ok {
+			setWatermarkToState(state, watermarkFromState(state).Next(seenItems(p.items, held)))
+		}
+		delete(fs.pending, urlStr)
+	}
+}
+
+func (fs *feedStates) put(state *model.Feed) {
+	if fs == nil || state == nil {
+		return
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.states[state.URL] = state
+}
+
+func (fs *feedStates) list() []*model.Feed {
+	if fs == nil {
+		return nil
+	}
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	list := make([]*model.Feed, 0, len(fs.states))
+	for _, state := range fs.states {
+		list = append(list, state)
+	}
+	return list
+}
+
+// SetCatchUp method sets catch-up mode. In catch-up mode, all items in feed subscribed first time
+// are marked as seen (not new).
+func (cfg *Config) SetCatchUp(flag bool) {
+	if cfg == nil {
+		return
+	}
+	cfg.catchUp = flag
+}
+
+// getFeedState method returns state of feed URL in database. If no state, returns new state.
+func (cfg *Config) getFeedState(ctx context.Context, urlStr string) *model.Feed {
+	data, err := cfg.repos.FindFeedByURL(ctx, urlStr)
+	if err != nil {
+		cfg.Logger().Error("error in finding feed state", zap.Object("error", zapobject.New(err)))
+		return &model.Feed{URL: urlStr}
+	}
+	if data == nil {
+		return &model.Feed{URL: urlStr}
+	}
+	return data
+}
+
+func validatorFromState(state *model.Feed) *feed.Validator {
+	return &feed.Validator{ETag: state.ETag, LastModified: state.LastModified}
+}
+
+// setValidatorToState function stores validators and fetched time to state of feed.
+func setValidatorToState(state *model.Feed, v *feed.Validator) {
+	if v == nil {
+		v = &feed.Validator{}
+	}
+	state.ETag = v.ETag
+	state.LastModified = v.LastModified
+	state.FetchedAt = sql.NullTime{Time: time.Now(), Valid: true}
+}
+
+func watermarkFromState(state *model.Feed) *feed.Watermark {
+	return &feed.Watermark{GUID: state.LastGUID, Published: state.GetLastPublished()}
+}
+
+func setWatermarkToState(state *model.Feed, wm *feed.Watermark) {
+	state.LastGUID = wm.GUID
+	state.SetLastPublished(wm.Published)
+}
+
+func (cfg *Config) saveFeedStates(ctx context.Context) error {
+	list := cfg.feedStates.list()
+	if len(list) == 0 {
+		return nil
+	}
+	cfg.Logger().Debug("start saving feed states to database", zap.Any("data", list))
+	res, err := cfg.repos.SaveFeeds(ctx, list)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	cfg.Logger().Debug("complete saving feed states to database", zap.Stringer("result", res))
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
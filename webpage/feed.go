@@ -2,9 +2,14 @@ package webpage
 
 import (
 	"context"
+	"fmt"
 	"net/url"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/goark/errs"
+	"github.com/goark/toolbox/db/model"
 	"github.com/goark/toolbox/ecode"
 	"github.com/goark/toolbox/webpage/feed"
 	"go.uber.org/zap"
@@ -12,71 +17,232 @@ import (
 
 // Feed fetches feed URL and gets webpage informations.
 func (cfg *Config) Feed(ctx context.Context, urlStr string) error {
-	if cfg == nil {
+	return cfg.FeedEntry(ctx, &FeedEntry{URL: urlStr})
+}
+
+// FeedEntry fetches feed in FeedEntry and gets webpage informations with options of the entry.
+func (cfg *Config) FeedEntry(ctx context.Context, entry *FeedEntry) error {
+	if cfg == nil || entry == nil {
 		return errs.Wrap(ecode.ErrNullPointer)
 	}
-	resp, err := importFeed(ctx, urlStr)
+	urlStr := entry.URL
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		return errs.Wrap(err, errs.WithContext("feed_url", urlStr))
+	}
+	f, err := entry.filter()
 	if err != nil {
 		return errs.Wrap(err, errs.WithContext("feed_url", urlStr))
 	}
-	cfg.getNewDataList(ctx, resp)
+	state := cfg.getFeedState(ctx, urlStr)
+	data, v, err := feed.FeedConditional(ctx, u, validatorFromState(state))
+	if err := cfg.importFeed(ctx, entry, f, state, data, v, err); err != nil {
+		return errs.Wrap(err, errs.WithContext("feed_url", urlStr))
+	}
 	return nil
 }
 
-// Feed fetches feed URL and gets webpage informations.
-func (cfg *Config) FeedFlickr(ctx context.Context, flickrId string) error {
+// FeedSource fetches feed of photo source ("type:id" string, e.g. "flickr:12345678@N00") and gets webpage informations.
+func (cfg *Config) FeedSource(ctx context.Context, spec string) error {
 	if cfg == nil {
 		return errs.Wrap(ecode.ErrNullPointer)
 	}
-	resp, err := imortFeedFlickr(ctx, flickrId)
+	src, err := feed.NewSource(spec)
 	if err != nil {
-		return errs.Wrap(err, errs.WithContext("flickr_id", flickrId))
+		return errs.Wrap(err)
+	}
+	state := cfg.getFeedState(ctx, src.FeedURL())
+	data, v, err := src.Fetch(ctx, validatorFromState(state))
+	if err := cfg.importFeed(ctx, nil, nil, state, data, v, err); err != nil {
+		return errs.Wrap(err, errs.WithContext("source", spec))
+	}
+	return nil
+}
+
+// importFeed method puts new items in feed to pool, and updates state of feed.
+// Global filter, filter of entry and maximum number of items in entry are applied to new items.
+func (cfg *Config) importFeed(ctx context.Context, entry *FeedEntry, f *feed.Filter, state *model.Feed, data *feed.Metadata, v *feed.Validator, errFetch error) error {
+	if errFetch != nil {
+		if errs.Is(errFetch, feed.ErrNotModified) {
+			cfg.Logger().Debug("feed not modified", zap.String("feed_url", state.URL))
+			setValidatorToState(state, v)
+			state.ErrorCount = 0
+			cfg.feedStates.put(state)
+			return nil
+		}
+		state.ErrorCount++
+		cfg.feedStates.put(state)
+		return errs.Wrap(errFetch)
 	}
-	cfg.getNewDataList(ctx, resp)
+	setValidatorToState(state, v)
+	state.ErrorCount = 0
+	items := []*feed.Item{}
+	if data != nil {
+		state.Title = data.Title
+		if data.Items != nil {
+			items = data.Items
+		}
+	}
+	wm := watermarkFromState(state)
+	if wm.IsZero() && cfg.catchUp {
+		cfg.Logger().Info("catch up feed (mark all items as seen)", zap.String("feed_url", state.URL), zap.Int("items", len(items)))
+		setWatermarkToState(state, wm.Next(items))
+		cfg.feedStates.put(state)
+		return nil
+	}
+	newItems := cfg.applyFilter(state.URL, cfg.filter, wm.NewItems(items))
+	deferred := cfg.getNewDataList(ctx, entry, cfg.applyFilter(state.URL, f, newItems))
+	// watermark is moved by Config.StopPool method, after results of item pool are settled.
+	cfg.feedStates.putPending(state, items, deferred)
 	return nil
 }
 
-func (cfg *Config) getNewDataList(ctx context.Context, items []*feed.Item) {
+// getNewDataList method puts new items to pool, and returns items deferred to next run by maximum number of items.
+// If maximum number of items is set, older items are put first.
+func (cfg *Config) getNewDataList(ctx context.Context, entry *FeedEntry, items []*feed.Item) []*feed.Item {
 	if cfg.itemPool == nil {
 		cfg.CreatePool()
 	}
+	maxItems := 0
+	if entry != nil && entry.MaxItems > 0 {
+		maxItems = entry.MaxItems
+		items = sortItemsByOldest(items)
+	}
+	deferred := []*feed.Item{}
+	count := 0
 	urls := map[string]bool{}
 	for _, item := range items {
-		if urls[item.Link] {
+		link := item.PermaLink()
+		if len(link) == 0 {
+			cfg.Logger().Debug("skip item (no link)", zap.Any("item", item))
 			continue
 		}
-		urls[item.Link] = true
-		if page, err := cfg.find(ctx, item.Link); err != nil || page == nil {
-			cfg.itemPool.putFeedItem(ctx, item)
+		if urls[link] {
+			continue
+		}
+		urls[link] = true
+		if page, err := cfg.find(ctx, link); err != nil || page == nil {
+			if maxItems > 0 && count >= maxItems {
+				cfg.Logger().Debug("defer item (max items)", zap.Any("item", item))
+				cfg.dropped.add(&feed.DroppedItem{Feed: entry.URL, Item: item, Reason: fmt.Sprintf("exceeded max items (%d), deferred to next run", maxItems)})
+				deferred = append(deferred, item)
+				continue
+			}
+			count++
+			cfg.itemPool.putFeedItem(ctx, item, entry)
 			cfg.Logger().Debug("new item", zap.Any("item", item))
 		}
 	}
+	return deferred
 }
 
-func importFeed(ctx context.Context, urlStr string) ([]*feed.Item, error) {
-	u, err := url.Parse(urlStr)
-	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", urlStr))
+// sortItemsByOldest function returns copy of items sorted by published time (oldest first).
+// Items are in order of newest first in feed, so items without time are regarded as older than next items.
+func sortItemsByOldest(items []*feed.Item) []*feed.Item {
+	list := make([]*feed.Item, len(items))
+	for i, item := range items {
+		list[len(items)-1-i] = item
 	}
-	data, err := feed.Feed(ctx, u)
-	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", urlStr))
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].Published == nil || list[j].Published == nil {
+			return list[i].Published == nil && list[j].Published != nil
+		}
+		return list[i].Published.Before(*list[j].Published)
+	})
+	return list
+}
+
+// seenItems function returns items in feed which watermark can be moved past:
+// items except deferred (or failed) ones and items newer than them (in published time or in position of feed).
+func seenItems(items, deferred []*feed.Item) []*feed.Item {
+	if len(deferred) == 0 {
+		return items
+	}
+	isDeferred := map[*feed.Item]bool{}
+	var oldest *time.Time
+	for _, item := range deferred {
+		isDeferred[item] = true
+		if item.Published != nil && (oldest == nil || item.Published.Before(*oldest)) {
+			oldest = item.Published
+		}
 	}
-	if data == nil || data.Items == nil {
-		return []*feed.Item{}, nil
+	last := -1
+	for i, item := range items {
+		if isDeferred[item] {
+			last = i
+		}
+	}
+	list := []*feed.Item{}
+	for i, item := range items {
+		switch {
+		case item == nil || isDeferred[item]:
+		case item.Published != nil && oldest != nil:
+			if item.Published.Before(*oldest) {
+				list = append(list, item)
+			}
+		case i > last:
+			list = append(list, item)
+		}
 	}
-	return data.Items, nil
+	return list
 }
 
-func imortFeedFlickr(ctx context.Context, flickrId string) ([]*feed.Item, error) {
-	data, err := feed.FeedFlickr(ctx, flickrId)
+// applyFilter method returns items passing filter, and records dropped items.
+func (cfg *Config) applyFilter(feedURL string, f *feed.Filter, items []*feed.Item) []*feed.Item {
+	passed, dropped := f.Apply(items)
+	for _, d := range dropped {
+		d.Feed = feedURL
+		cfg.Logger().Debug("drop item", zap.String("feed_url", feedURL), zap.String("link", d.Item.Link), zap.String("reason", d.Reason))
+		cfg.dropped.add(d)
+	}
+	return passed
+}
+
+// SetFilter method sets global filtering rules for all feeds.
+func (cfg *Config) SetFilter(rules []*feed.Rule) error {
+	if cfg == nil {
+		return errs.Wrap(ecode.ErrNullPointer)
+	}
+	f, err := feed.NewFilter(rules)
 	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("flickr_id", flickrId))
+		return errs.Wrap(err)
 	}
-	if data == nil || data.Items == nil {
-		return []*feed.Item{}, nil
+	cfg.filter = f
+	return nil
+}
+
+// DroppedItems method returns items dropped by filters or maximum number of items.
+func (cfg *Config) DroppedItems() []*feed.DroppedItem {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.dropped.list()
+}
+
+// droppedItems is list of dropped items (goroutine safe).
+type droppedItems struct {
+	mu    sync.Mutex
+	items []*feed.DroppedItem
+}
+
+func (di *droppedItems) add(item *feed.DroppedItem) {
+	if di == nil {
+		return
+	}
+	di.mu.Lock()
+	defer di.mu.Unlock()
+	di.items = append(di.items, item)
+}
+
+func (di *droppedItems) list() []*feed.DroppedItem {
+	if di == nil {
+		return nil
 	}
-	return data.Items, nil
+	di.mu.Lock()
+	defer di.mu.Unlock()
+	cpy := make([]*feed.DroppedItem, len(di.items))
+	copy(cpy, di.items)
+	return cpy
 }
 
 /* Copyright 2023 Spiegel
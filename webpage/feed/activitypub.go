@@ -0,0 +1,276 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/goark/errs"
+)
+
+// activityJSON is value of Accept header for ActivityPub request.
+const activityJSON = `application/activity+json, application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+
+// activityPubSource is PhotoSource for outbox of ActivityPub actor (Pixelfed, ...).
+type activityPubSource struct {
+	id string
+	u  *url.URL
+}
+
+var _ PhotoSource = (*activityPubSource)(nil)
+
+// newActivityPubSource function returns PhotoSource for ActivityPub account ("user@host") or URL of outbox.
+func newActivityPubSource(id string) (PhotoSource, error) {
+	u, err := activityPubOutboxURL(id)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &activityPubSource{id: id, u: u}, nil
+}
+
+func activityPubOutboxURL(id string) (*url.URL, error) {
+	if strings.HasPrefix(id, "https://") || strings.HasPrefix(id, "http://") {
+		u, err := url.Parse(id)
+		if err != nil {
+			return nil, errs.Wrap(ErrInvalidSource, errs.WithCause(err), errs.WithContext("id", id))
+		}
+		return u, nil
+	}
+	user, host, ok := strings.Cut(strings.TrimPrefix(id, "@"), "@")
+	if !ok || len(user) == 0 || len(host) == 0 {
+		return nil, errs.Wrap(ErrInvalidSource, errs.WithContext("id", id))
+	}
+	return &url.URL{Scheme: "https", Host: host, Path: "/users/" + user + "/outbox"}, nil
+}
+
+// Type method returns type name of photo source (PhotoSource interface).
+func (as *activityPubSource) Type() string {
+	return "activitypub"
+}
+
+// ID method returns account or URL of outbox (PhotoSource interface).
+func (as *activityPubSource) ID() string {
+	return as.id
+}
+
+// FeedURL method returns URL of outbox (PhotoSource interface).
+func (as *activityPubSource) FeedURL() string {
+	return as.u.String()
+}
+
+// Fetch method fetches posts with images in outbox with conditional request (PhotoSource interface).
+// If outbox has no items, the first page of outbox is fetched.
+func (as *activityPubSource) Fetch(ctx context.Context, v *Validator) (*Metadata, *Validator, error) {
+	body, nv, err := getConditional(ctx, as.u, v, activityJSON)
+	if err != nil {
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", as.u.String()))
+	}
+	defer body.Close()
+
+	c, err := decodeAPCollection(body)
+	if err != nil {
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", as.u.String()))
+	}
+	if len(c.items()) == 0 && len(c.First) > 0 {
+		// items in first page
+		var first string
+		if err := json.Unmarshal(c.First, &first); err == nil {
+			u, err := as.u.Parse(first)
+			if err != nil {
+				return nil, nv, errs.Wrap(err, errs.WithContext("url", first))
+			}
+			page, _, err := getConditional(ctx, u, nil, activityJSON)
+			if err != nil {
+				return nil, nv, errs.Wrap(err, errs.WithContext("url", u.String()))
+			}
+			defer page.Close()
+			if c, err = decodeAPCollection(page); err != nil {
+				return nil, nv, errs.Wrap(err, errs.WithContext("url", u.String()))
+			}
+		} else if err := json.Unmarshal(c.First, c); err != nil {
+			return nil, nv, errs.Wrap(err, errs.WithContext("url", as.u.String()))
+		}
+	}
+
+	data := &Metadata{
+		FeedType: "activitypub",
+		FeedLink: as.u.String(),
+		Title:    as.id,
+		ID:       as.u.String(),
+	}
+	items := []*Item{}
+	for _, raw := range c.items() {
+		if item := as.itemFrom(raw); item != nil {
+			items = append(items, item)
+		}
+	}
+	data.Items = items
+	return data, nv, nil
+}
+
+// itemFrom method returns Item from activity (Create) or object. If object has no image, returns nil.
+func (as *activityPubSource) itemFrom(raw json.RawMessage) *Item {
+	var act apObject
+	if err := json.Unmarshal(raw, &act); err != nil {
+		return nil
+	}
+	obj := &act
+	if strings.EqualFold(act.Type, "Create") {
+		obj = &apObject{}
+		if err := json.Unmarshal(act.Object, obj); err != nil {
+			return nil // object is not embedded
+		}
+	}
+	text := htmlText(obj.Content)
+	item := &Item{
+		GUID:        obj.ID,
+		Title:       obj.Name,
+		Description: text,
+		Content:     obj.Content,
+		Link:        apLink(obj.URL),
+	}
+	if len(item.Title) == 0 {
+		item.Title = firstLine(text)
+	}
+	if len(item.Link) == 0 {
+		item.Link = obj.ID
+	}
+	if t, err := time.Parse(time.RFC3339, obj.Published); err == nil {
+		item.Published = &t
+	}
+	if actor := apLink(obj.AttributedTo); len(actor) > 0 {
+		item.Authors = []*Author{{Name: as.id, URL: actor}}
+	}
+	for _, tag := range obj.Tag {
+		if strings.EqualFold(tag.Type, "Hashtag") && len(tag.Name) > 0 {
+			item.Categories = append(item.Categories, strings.TrimPrefix(tag.Name, "#"))
+		}
+	}
+	for _, a := range obj.Attachment {
+		u := apLink(a.URL)
+		if len(u) == 0 {
+			continue
+		}
+		if strings.EqualFold(a.Type, "Image") || strings.HasPrefix(strings.ToLower(a.MediaType), "image/") {
+			item.Images = append(item.Images, &Image{MimeType: a.MediaType, Title: a.Name, URL: u})
+		}
+	}
+	if len(item.Images) == 0 {
+		return nil
+	}
+	fillImages(item)
+	return item
+}
+
+// apCollection is (ordered) collection or collection page in ActivityStreams.
+type apCollection struct {
+	OrderedItems []json.RawMessage `json:"orderedItems"`
+	Items        []json.RawMessage `json:"items"`
+	First        json.RawMessage   `json:"first"`
+}
+
+func (c *apCollection) items() []json.RawMessage {
+	if len(c.OrderedItems) > 0 {
+		return c.OrderedItems
+	}
+	return c.Items
+}
+
+func decodeAPCollection(r io.Reader) (*apCollection, error) {
+	c := &apCollection{}
+	if err := json.NewDecoder(r).Decode(c); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return c, nil
+}
+
+// apObject is object (or activity) in ActivityStreams.
+type apObject struct {
+	ID           string          `json:"id"`
+	Type         string          `json:"type"`
+	Name         string          `json:"name"`
+	Content      string          `json:"content"`
+	URL          json.RawMessage `json:"url"`
+	Published    string          `json:"published"`
+	AttributedTo json.RawMessage `json:"attributedTo"`
+	Object       json.RawMessage `json:"object"`
+	Attachment   []struct {
+		Type      string          `json:"type"`
+		MediaType string          `json:"mediaType"`
+		Name      string          `json:"name"`
+		URL       json.RawMessage `json:"url"`
+	} `json:"attachment"`
+	Tag []struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	} `json:"tag"`
+}
+
+// apLink function returns URL from string, Link object or array of them in ActivityStreams.
+func apLink(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var link struct {
+		Href string `json:"href"`
+		ID   string `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &link); err == nil {
+		if len(link.Href) > 0 {
+			return link.Href
+		}
+		return link.ID
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		for _, l := range list {
+			if s := apLink(l); len(s) > 0 {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// htmlLineBreaks inserts line breaks before HTML elements breaking lines.
+var htmlLineBreaks = strings.NewReplacer("<br", "\n<br", "<p>", "\n<p>")
+
+// htmlText function returns text in HTML fragment.
+func htmlText(s string) string {
+	if len(s) == 0 {
+		return ""
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlLineBreaks.Replace(s)))
+	if err != nil {
+		return s
+	}
+	return strings.TrimSpace(doc.Text())
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(line)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
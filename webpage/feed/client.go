@@ -0,0 +1,50 @@
+package feed
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/goark/toolbox/consts"
+)
+
+// DefaultTimeout is timeout of HTTP requests for feeds and Web pages.
+const DefaultTimeout = 30 * time.Second
+
+// userAgentTransport is http.RoundTripper setting User-Agent header.
+type userAgentTransport struct {
+	base http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(req.Header.Get("User-Agent")) == 0 {
+		req = req.Clone(req.Context())
+		req.Header.Set("User-Agent", consts.UserAgent)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// httpClient is shared HTTP client with timeout and User-Agent header.
+var httpClient = &http.Client{
+	Timeout:   DefaultTimeout,
+	Transport: &userAgentTransport{base: http.DefaultTransport},
+}
+
+// HTTPClient function returns shared HTTP client for fetching feeds and Web pages.
+func HTTPClient() *http.Client {
+	return httpClient
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,97 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/goark/errs"
+	ftch "github.com/goark/fetch"
+)
+
+// Validator is set of validators for conditional request (ETag and Last-Modified header).
+type Validator struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// IsZero method returns true if Validator has no validators.
+func (v *Validator) IsZero() bool {
+	return v == nil || (len(v.ETag) == 0 && len(v.LastModified) == 0)
+}
+
+// FeedConditional fetches feed data from URL with conditional request,
+// and returns validators in response. If feed is not modified (status 304), returns ErrNotModified.
+func FeedConditional(ctx context.Context, u *url.URL, v *Validator) (*Metadata, *Validator, error) {
+	body, nv, err := getConditional(ctx, u, v, "")
+	if err != nil {
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	defer body.Close()
+
+	data, err := decodeFeed(body)
+	if err != nil {
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	return data, nv, nil
+}
+
+// getConditional function sends GET request with validators (If-None-Match and If-Modified-Since header).
+// If accept is not empty, Accept header is set.
+func getConditional(ctx context.Context, u *url.URL, v *Validator, accept string) (io.ReadCloser, *Validator, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", accept)
+	}
+	if v != nil {
+		if len(v.ETag) > 0 {
+			req.Header.Set("If-None-Match", v.ETag)
+		}
+		if len(v.LastModified) > 0 {
+			req.Header.Set("If-Modified-Since", v.LastModified)
+		}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	nv := &Validator{ETag: resp.Header.Get("ETag"), LastModified: resp.Header.Get("Last-Modified")}
+	switch {
+	case resp.StatusCode == http.StatusNotModified:
+		resp.Body.Close()
+		// validators may be omitted in 304 response
+		if v != nil {
+			if len(nv.ETag) == 0 {
+				nv.ETag = v.ETag
+			}
+			if len(nv.LastModified) == 0 {
+				nv.LastModified = v.LastModified
+			}
+		}
+		return nil, nv, errs.Wrap(ErrNotModified)
+	case resp.StatusCode == 0 || resp.StatusCode >= http.StatusBadRequest:
+		resp.Body.Close()
+		return nil, nil, errs.Wrap(fmt.Errorf("%w: status %d", ftch.ErrHTTPStatus, resp.StatusCode), errs.WithContext("status", resp.StatusCode))
+	}
+	return resp.Body, nv, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,82 @@
+package feed_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/toolbox/consts"
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+const testRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0"><channel><title>test</title><link>https://example.com/</link>
+<item><title>item 1</title><link>https://example.com/1</link></item>
+</channel></rss>`
+
+func TestFeedConditional(t *testing.T) {
+	const etag = `"v1"`
+	const lastModified = "Mon, 02 Jan 2006 15:04:05 GMT"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ua := r.Header.Get("User-Agent"); ua != consts.UserAgent {
+			http.Error(w, "unexpected User-Agent: "+ua, http.StatusForbidden)
+			return
+		}
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Last-Modified", lastModified)
+		_, _ = w.Write([]byte(testRSS))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	testCases := []struct {
+		v     *feed.Validator
+		items int
+		err   error
+	}{
+		{v: nil, items: 1, err: nil},
+		{v: &feed.Validator{ETag: `"v0"`}, items: 1, err: nil},
+		{v: &feed.Validator{ETag: etag, LastModified: lastModified}, items: 0, err: feed.ErrNotModified},
+	}
+	for _, tc := range testCases {
+		data, v, err := feed.FeedConditional(context.Background(), u, tc.v)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("FeedConditional(%+v) error = \"%+v\", want \"%+v\".", tc.v, err, tc.err)
+			continue
+		}
+		if v == nil || v.ETag != etag || v.LastModified != lastModified {
+			t.Errorf("FeedConditional(%+v) validator = %+v, want %v and %v.", tc.v, v, etag, lastModified)
+		}
+		if err == nil && len(data.Items) != tc.items {
+			t.Errorf("FeedConditional(%+v) items = %v, want %v.", tc.v, len(data.Items), tc.items)
+		}
+	}
+}
+
+func TestHTTPClient(t *testing.T) {
+	if cli := feed.HTTPClient(); cli.Timeout != feed.DefaultTimeout {
+		t.Errorf("HTTPClient().Timeout = %v, want %v.", cli.Timeout, feed.DefaultTimeout)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
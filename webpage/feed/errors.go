@@ -5,6 +5,9 @@ import "errors"
 var (
 	ErrNullPointer     = errors.New("null reference instance")
 	ErrInvalidFlickrId = errors.New("invalid Flickr user ID")
+	ErrNotModified     = errors.New("feed not modified")
+	ErrInvalidFilter   = errors.New("invalid filter rule")
+	ErrInvalidSource   = errors.New("invalid photo source")
 )
 
 /* Copyright 2023 Spiegel
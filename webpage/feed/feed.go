@@ -1,9 +1,11 @@
 package feed
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"net/url"
+	"strconv"
 
 	"github.com/goark/errs"
 	ftch "github.com/goark/fetch"
@@ -12,7 +14,7 @@ import (
 
 // Feed fetches feed data from URL.
 func Feed(ctx context.Context, u *url.URL) (*Metadata, error) {
-	resp, err := ftch.New().GetWithContext(ctx, u)
+	resp, err := ftch.New(ftch.WithHTTPClient(httpClient)).GetWithContext(ctx, u)
 	if err != nil {
 		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
 	}
@@ -21,16 +23,28 @@ func Feed(ctx context.Context, u *url.URL) (*Metadata, error) {
 	return decodeFeed(resp.Body())
 }
 
+// decodeFeed function decodes RSS, Atom and JSON Feed data.
 func decodeFeed(r io.Reader) (*Metadata, error) {
-	f, err := gofeed.NewParser().Parse(r)
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	f, err := gofeed.NewParser().Parse(bytes.NewReader(b))
 	if err != nil {
 		return nil, errs.Wrap(err)
 	}
 	data := &Metadata{
+		FeedType:    f.FeedType,
 		FeedLink:    f.FeedLink,
 		Title:       f.Title,
 		Description: f.Description,
 		Link:        f.Link,
+		Categories:  f.Categories,
+	}
+	if f.Image != nil && len(f.Image.URL) > 0 {
+		data.Image = &Image{Title: f.Image.Title, URL: f.Image.URL}
+	} else if f.ITunesExt != nil && len(f.ITunesExt.Image) > 0 {
+		data.Image = &Image{Title: f.Title, URL: f.ITunesExt.Image}
 	}
 	authors := []*Author{}
 	for _, a := range f.Authors {
@@ -40,9 +54,12 @@ func decodeFeed(r io.Reader) (*Metadata, error) {
 	items := []*Item{}
 	for _, i := range f.Items {
 		item := &Item{
+			GUID:        i.GUID,
 			Title:       i.Title,
 			Description: i.Description,
+			Content:     i.Content,
 			Link:        i.Link,
+			Categories:  i.Categories,
 			Published:   i.PublishedParsed,
 			Updated:     i.UpdatedParsed,
 		}
@@ -54,8 +71,24 @@ func decodeFeed(r io.Reader) (*Metadata, error) {
 		if i.Image != nil {
 			item.Images = []*Image{{Title: i.Image.Title, URL: i.Image.URL}}
 		}
+		for _, e := range i.Enclosures {
+			if e != nil && len(e.URL) > 0 {
+				length, _ := strconv.ParseInt(e.Length, 10, 64)
+				item.Enclosures = append(item.Enclosures, &Enclosure{URL: e.URL, Type: e.Type, Length: length})
+			}
+		}
+		item.Images = mergeImages(item.Images, mediaContents(i.Extensions, i.Title))
+		item.Thumbnails = mediaThumbnails(i.Extensions, i.Title)
+		item.InReplyTo = inReplyTo(i.Extensions)
+		item.Podcast = podcastFromITunes(i.ITunesExt)
 		items = append(items, item)
 	}
+	switch f.FeedType {
+	case "atom":
+		setAtomLinks(b, items)
+	case "json":
+		setJSONFeedData(b, items)
+	}
 	data.Items = items
 	return data, nil
 }
@@ -0,0 +1,228 @@
+package feed
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/goark/errs"
+)
+
+const (
+	// Actions of filtering rule
+	ActionInclude = "include"
+	ActionExclude = "exclude"
+
+	// Fields of item for filtering rule
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldAuthor      = "author"
+	FieldCategory    = "category"
+	FieldLink        = "link"
+)
+
+var (
+	fieldList     = []string{FieldTitle, FieldDescription, FieldAuthor, FieldCategory, FieldLink}
+	defaultFields = []string{FieldTitle, FieldDescription}
+)
+
+// Rule is filtering rule for feed items.
+// Include rules: items must match at least one of include rules (if any).
+// Exclude rules: items must not match any of exclude rules.
+// Keywords are matched case-insensitively. Fields are title and description if omitted.
+type Rule struct {
+	Name     string   `json:"name,omitempty" yaml:"name,omitempty" toml:"name,omitempty" mapstructure:"name"`
+	Action   string   `json:"action,omitempty" yaml:"action,omitempty" toml:"action,omitempty" mapstructure:"action"`
+	Fields   []string `json:"fields,omitempty" yaml:"fields,omitempty" toml:"fields,omitempty" mapstructure:"fields"`
+	Keywords []string `json:"keywords,omitempty" yaml:"keywords,omitempty" toml:"keywords,omitempty" mapstructure:"keywords"`
+	Regexps  []string `json:"regexps,omitempty" yaml:"regexps,omitempty" toml:"regexps,omitempty" mapstructure:"regexps"`
+}
+
+// Filter is compiled filtering rules.
+type Filter struct {
+	includes []*compiledRule
+	excludes []*compiledRule
+}
+
+type compiledRule struct {
+	name     string
+	fields   []string
+	keywords []string
+	regexps  []*regexp.Regexp
+}
+
+// NewFilter function compiles rules and returns new Filter instance.
+func NewFilter(rules []*Rule) (*Filter, error) {
+	f := &Filter{}
+	for i, r := range rules {
+		if r == nil {
+			continue
+		}
+		cr, err := r.compile()
+		if err != nil {
+			return nil, errs.Wrap(err, errs.WithContext("rule", i))
+		}
+		if len(cr.name) == 0 {
+			cr.name = fmt.Sprintf("#%d", i+1)
+		}
+		switch strings.ToLower(r.Action) {
+		case ActionExclude:
+			f.excludes = append(f.excludes, cr)
+		case ActionInclude, "":
+			f.includes = append(f.includes, cr)
+		default:
+			return nil, errs.Wrap(ErrInvalidFilter, errs.WithContext("rule", i), errs.WithContext("action", r.Action))
+		}
+	}
+	return f, nil
+}
+
+func (r *Rule) compile() (*compiledRule, error) {
+	cr := &compiledRule{name: r.Name, fields: defaultFields}
+	if len(r.Fields) > 0 {
+		cr.fields = []string{}
+		for _, field := range r.Fields {
+			field = strings.ToLower(strings.TrimSpace(field))
+			if !contains(fieldList, field) {
+				return nil, errs.Wrap(ErrInvalidFilter, errs.WithContext("field", field))
+			}
+			cr.fields = append(cr.fields, field)
+		}
+	}
+	for _, kw := range r.Keywords {
+		if kw = strings.ToLower(strings.TrimSpace(kw)); len(kw) > 0 {
+			cr.keywords = append(cr.keywords, kw)
+		}
+	}
+	for _, expr := range r.Regexps {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errs.Wrap(ErrInvalidFilter, errs.WithCause(err), errs.WithContext("regexp", expr))
+		}
+		cr.regexps = append(cr.regexps, re)
+	}
+	if len(cr.keywords) == 0 && len(cr.regexps) == 0 {
+		return nil, errs.Wrap(ErrInvalidFilter, errs.WithContext("detail", "no keywords or regexps"))
+	}
+	return cr, nil
+}
+
+// match method returns description of matching if item matches rule, or empty string.
+func (cr *compiledRule) match(item *Item) string {
+	for _, field := range cr.fields {
+		for _, value := range fieldValues(item, field) {
+			lower := strings.ToLower(value)
+			for _, kw := range cr.keywords {
+				if strings.Contains(lower, kw) {
+					return fmt.Sprintf("keyword %q in %s", kw, field)
+				}
+			}
+			for _, re := range cr.regexps {
+				if re.MatchString(value) {
+					return fmt.Sprintf("regexp %q in %s", re.String(), field)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func fieldValues(item *Item, field string) []string {
+	switch field {
+	case FieldTitle:
+		return []string{item.Title}
+	case FieldDescription:
+		return []string{item.Description}
+	case FieldLink:
+		return []string{item.Link}
+	case FieldCategory:
+		return item.Categories
+	case FieldAuthor:
+		names := make([]string, 0, len(item.Authors))
+		for _, a := range item.Authors {
+			if a != nil {
+				names = append(names, a.Name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// IsEmpty method returns true if Filter has no rules.
+func (f *Filter) IsEmpty() bool {
+	return f == nil || (len(f.includes) == 0 && len(f.excludes) == 0)
+}
+
+// Check method returns true if item passes filter. If item is dropped, returns reason.
+func (f *Filter) Check(item *Item) (bool, string) {
+	if f.IsEmpty() || item == nil {
+		return true, ""
+	}
+	for _, cr := range f.excludes {
+		if m := cr.match(item); len(m) > 0 {
+			return false, fmt.Sprintf("excluded by rule %s (%s)", cr.name, m)
+		}
+	}
+	if len(f.includes) == 0 {
+		return true, ""
+	}
+	for _, cr := range f.includes {
+		if m := cr.match(item); len(m) > 0 {
+			return true, ""
+		}
+	}
+	names := make([]string, 0, len(f.includes))
+	for _, cr := range f.includes {
+		names = append(names, cr.name)
+	}
+	return false, "not matched any include rules (" + strings.Join(names, ", ") + ")"
+}
+
+// DroppedItem is item dropped by filter, with reason.
+type DroppedItem struct {
+	Feed   string `json:"feed,omitempty"`
+	Item   *Item  `json:"item"`
+	Reason string `json:"reason"`
+}
+
+// Apply method returns items passing filter and dropped items with reasons.
+func (f *Filter) Apply(items []*Item) ([]*Item, []*DroppedItem) {
+	if f.IsEmpty() {
+		return items, nil
+	}
+	passed := []*Item{}
+	dropped := []*DroppedItem{}
+	for _, item := range items {
+		if ok, reason := f.Check(item); ok {
+			passed = append(passed, item)
+		} else {
+			dropped = append(dropped, &DroppedItem{Item: item, Reason: reason})
+		}
+	}
+	return passed, dropped
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
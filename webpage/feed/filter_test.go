@@ -0,0 +1,87 @@
+package feed_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+func TestFilterCheck(t *testing.T) {
+	item := &feed.Item{
+		Title:       "Hubble finds a new Galaxy",
+		Description: "Sponsored: telescope sale",
+		Link:        "https://example.com/astro/123",
+		Authors:     []*feed.Author{{Name: "Alice"}},
+		Categories:  []string{"Space", "Science"},
+	}
+	testCases := []struct {
+		rules  []*feed.Rule
+		ok     bool
+		reason string
+	}{
+		{rules: nil, ok: true, reason: ""},
+		{rules: []*feed.Rule{{Keywords: []string{"galaxy"}}}, ok: true, reason: ""},
+		{rules: []*feed.Rule{{Keywords: []string{"nebula"}}}, ok: false, reason: "not matched any include rules (#1)"},
+		{rules: []*feed.Rule{{Name: "ads", Action: "exclude", Keywords: []string{"sponsored"}}}, ok: false, reason: `excluded by rule ads (keyword "sponsored" in description)`},
+		{rules: []*feed.Rule{{Action: "exclude", Fields: []string{"title"}, Keywords: []string{"sponsored"}}}, ok: true, reason: ""},
+		{rules: []*feed.Rule{{Fields: []string{"category"}, Keywords: []string{"space"}}, {Keywords: []string{"nebula"}}}, ok: true, reason: ""},
+		{rules: []*feed.Rule{{Fields: []string{"author"}, Regexps: []string{`^Bob$`}}}, ok: false, reason: "not matched any include rules (#1)"},
+		{rules: []*feed.Rule{{Name: "path", Action: "exclude", Fields: []string{"link"}, Regexps: []string{`/astro/\d+$`}}}, ok: false, reason: `excluded by rule path (regexp "/astro/\\d+$" in link)`},
+	}
+	for _, tc := range testCases {
+		f, err := feed.NewFilter(tc.rules)
+		if err != nil {
+			t.Errorf("NewFilter() error = \"%+v\", want <nil>.", err)
+			continue
+		}
+		ok, reason := f.Check(item)
+		if ok != tc.ok || reason != tc.reason {
+			t.Errorf("Filter.Check() = %v, %q, want %v, %q.", ok, reason, tc.ok, tc.reason)
+		}
+	}
+}
+
+func TestNewFilterError(t *testing.T) {
+	testCases := []struct {
+		rule *feed.Rule
+	}{
+		{rule: &feed.Rule{}},
+		{rule: &feed.Rule{Action: "drop", Keywords: []string{"a"}}},
+		{rule: &feed.Rule{Fields: []string{"body"}, Keywords: []string{"a"}}},
+		{rule: &feed.Rule{Regexps: []string{"("}}},
+	}
+	for _, tc := range testCases {
+		if _, err := feed.NewFilter([]*feed.Rule{tc.rule}); !errors.Is(err, feed.ErrInvalidFilter) {
+			t.Errorf("NewFilter(%+v) error = \"%+v\", want \"%+v\".", tc.rule, err, feed.ErrInvalidFilter)
+		}
+	}
+}
+
+func TestFilterApply(t *testing.T) {
+	items := []*feed.Item{{Title: "Galaxy"}, {Title: "Sale"}, {Title: "Nebula"}}
+	f, err := feed.NewFilter([]*feed.Rule{{Action: "exclude", Keywords: []string{"sale"}}})
+	if err != nil {
+		t.Fatalf("NewFilter() error = \"%+v\", want <nil>.", err)
+	}
+	passed, dropped := f.Apply(items)
+	if len(passed) != 2 || len(dropped) != 1 || dropped[0].Item.Title != "Sale" || !strings.HasPrefix(dropped[0].Reason, "excluded") {
+		t.Errorf("Filter.Apply() = %v, %v, want 2 passed and 1 dropped.", passed, dropped)
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
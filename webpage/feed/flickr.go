@@ -8,23 +8,53 @@ import (
 	"time"
 
 	"github.com/goark/errs"
-	ftch "github.com/goark/fetch"
 	"github.com/mmcdole/gofeed/atom"
 )
 
-// FeedFlickr fetches feed data from Flickr ID.
-func FeedFlickr(ctx context.Context, flickrId string) (*Metadata, error) {
+// flickrSource is PhotoSource for public photos in Flickr.
+type flickrSource struct {
+	id string
+	u  *url.URL
+}
+
+var _ PhotoSource = (*flickrSource)(nil)
+
+func newFlickrSource(flickrId string) (PhotoSource, error) {
 	u, err := makeFlickrFeedURL(flickrId)
 	if err != nil {
-		return nil, errs.Wrap(ErrInvalidFlickrId, errs.WithContext("flickr_id", flickrId))
+		return nil, errs.Wrap(err)
 	}
-	resp, err := ftch.New().GetWithContext(ctx, u)
+	return &flickrSource{id: flickrId, u: u}, nil
+}
+
+// Type method returns type name of photo source (PhotoSource interface).
+func (fs *flickrSource) Type() string {
+	return "flickr"
+}
+
+// ID method returns Flickr ID (PhotoSource interface).
+func (fs *flickrSource) ID() string {
+	return fs.id
+}
+
+// FeedURL method returns URL of public feed for Flickr ID (PhotoSource interface).
+func (fs *flickrSource) FeedURL() string {
+	return fs.u.String()
+}
+
+// Fetch method fetches public feed for Flickr ID with conditional request (PhotoSource interface).
+func (fs *flickrSource) Fetch(ctx context.Context, v *Validator) (*Metadata, *Validator, error) {
+	body, nv, err := getConditional(ctx, fs.u, v, "")
 	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", fs.u.String()))
 	}
-	defer resp.Close()
+	defer body.Close()
 
-	return decodeFlickrFeed(resp.Body())
+	data, err := decodeFlickrFeed(body)
+	if err != nil {
+		return nil, nv, errs.Wrap(err, errs.WithContext("url", fs.u.String()))
+	}
+	return data, nv, nil
 }
 
 func makeFlickrFeedURL(flickrId string) (*url.URL, error) {
@@ -61,11 +91,17 @@ func decodeFlickrFeed(r io.Reader) (*Metadata, error) {
 	items := []*Item{}
 	for _, i := range f.Entries {
 		item := &Item{
+			GUID:      i.ID,
 			Title:     i.Title,
 			Published: i.PublishedParsed,
 			Updated:   i.UpdatedParsed,
 		}
-		image := &Image{}
+		for _, c := range i.Categories {
+			if c != nil && len(c.Term) > 0 {
+				item.Categories = append(item.Categories, c.Term)
+			}
+		}
+		image := &Image{Title: i.Title}
 		for _, lnk := range i.Links {
 			switch {
 			case strings.EqualFold(lnk.Rel, "alternate"):
@@ -86,6 +122,7 @@ func decodeFlickrFeed(r io.Reader) (*Metadata, error) {
 			}
 		}
 		item.Images = []*Image{image}
+		fillImages(item)
 		authors := []*Author{}
 		for _, a := range i.Authors {
 			authors = append(authors, &Author{Name: a.Name, URL: a.URI})
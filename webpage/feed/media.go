@@ -0,0 +1,101 @@
+package feed
+
+import (
+	"strings"
+
+	ext "github.com/mmcdole/gofeed/extensions"
+)
+
+// mediaThumbnails function returns images of media:thumbnail elements in Media RSS extension
+// (including elements in media:group and media:content).
+func mediaThumbnails(exts ext.Extensions, title string) []*Image {
+	media, ok := exts["media"]
+	if !ok {
+		return nil
+	}
+	return thumbnailsFrom(media, title)
+}
+
+// mediaContents function returns images of media:content elements (medium="image" or image MIME type) in Media RSS extension
+// (including elements in media:group).
+func mediaContents(exts ext.Extensions, title string) []*Image {
+	media, ok := exts["media"]
+	if !ok {
+		return nil
+	}
+	return contentsFrom(media, title)
+}
+
+func contentsFrom(elements map[string][]ext.Extension, title string) []*Image {
+	var images []*Image
+	for _, e := range elements["content"] {
+		u := e.Attrs["url"]
+		if len(u) == 0 {
+			continue
+		}
+		typ := e.Attrs["type"]
+		if strings.EqualFold(e.Attrs["medium"], "image") || strings.HasPrefix(strings.ToLower(typ), "image/") {
+			img := &Image{MimeType: typ, Title: title, URL: u}
+			for _, t := range e.Children["title"] {
+				if len(t.Value) > 0 {
+					img.Title = t.Value
+				}
+			}
+			images = append(images, img)
+		}
+	}
+	for _, e := range elements["group"] {
+		images = append(images, contentsFrom(e.Children, title)...)
+	}
+	return images
+}
+
+// mergeImages function appends images which is not in list. MIME type of image in list is completed by same URL.
+func mergeImages(list []*Image, images []*Image) []*Image {
+	for _, img := range images {
+		found := false
+		for _, i := range list {
+			if i.URL == img.URL {
+				if len(i.MimeType) == 0 {
+					i.MimeType = img.MimeType
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, img)
+		}
+	}
+	return list
+}
+
+func thumbnailsFrom(elements map[string][]ext.Extension, title string) []*Image {
+	var images []*Image
+	for _, e := range elements["thumbnail"] {
+		if u := e.Attrs["url"]; len(u) > 0 {
+			images = append(images, &Image{Title: title, URL: u})
+		}
+	}
+	for _, name := range []string{"group", "content"} {
+		for _, e := range elements[name] {
+			images = append(images, thumbnailsFrom(e.Children, title)...)
+		}
+	}
+	return images
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,76 @@
+package feed_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+const testMediaRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/" xmlns:content="http://purl.org/rss/1.0/modules/content/">
+<channel><title>test</title><link>https://example.com/</link><category>photo</category>
+<image><url>https://example.com/logo.png</url><title>logo</title><link>https://example.com/</link></image>
+<item><title>item 1</title><link>https://example.com/1</link><guid>item-1</guid>
+<category>cat</category><category>dog</category>
+<content:encoded><![CDATA[<p>content</p>]]></content:encoded>
+<enclosure url="https://example.com/1.mp3" length="1234" type="audio/mpeg"/>
+<media:group><media:content url="https://example.com/1.jpg" medium="image"><media:thumbnail url="https://example.com/1-thumb.jpg"/></media:content></media:group>
+</item>
+</channel></rss>`
+
+func TestDecodeMediaRSS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testMediaRSS))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	data, _, err := feed.FeedConditional(context.Background(), u, nil)
+	if err != nil {
+		t.Fatalf("FeedConditional() error = \"%+v\", want nil.", err)
+	}
+	if len(data.Categories) != 1 || data.Categories[0] != "photo" {
+		t.Errorf("Metadata.Categories = %v, want %v.", data.Categories, []string{"photo"})
+	}
+	if data.Image == nil || data.Image.URL != "https://example.com/logo.png" {
+		t.Errorf("Metadata.Image = %v, want %v.", data.Image, "https://example.com/logo.png")
+	}
+	if len(data.Items) != 1 {
+		t.Fatalf("count of Metadata.Items = %v, want %v.", len(data.Items), 1)
+	}
+	item := data.Items[0]
+	if item.Content != "<p>content</p>" {
+		t.Errorf("Item.Content = %v, want %v.", item.Content, "<p>content</p>")
+	}
+	if len(item.Categories) != 2 {
+		t.Errorf("Item.Categories = %v, want %v.", item.Categories, []string{"cat", "dog"})
+	}
+	if len(item.Enclosures) != 1 || item.Enclosures[0].Length != 1234 || item.Enclosures[0].IsImage() {
+		t.Errorf("Item.Enclosures = %v, want 1 audio enclosure.", item.Enclosures)
+	}
+	if img := item.FindImage(); img == nil || img.URL != "https://example.com/1.jpg" {
+		t.Errorf("Item.FindImage() = %v, want %v.", img, "https://example.com/1.jpg")
+	}
+	if len(item.Thumbnails) != 1 || item.Thumbnails[0].URL != "https://example.com/1-thumb.jpg" {
+		t.Errorf("Item.Thumbnails = %v, want %v.", item.Thumbnails, "https://example.com/1-thumb.jpg")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
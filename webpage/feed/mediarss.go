@@ -0,0 +1,79 @@
+package feed
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/goark/errs"
+)
+
+// mediaRSSSource is PhotoSource for generic photo gallery in Media RSS.
+type mediaRSSSource struct {
+	u *url.URL
+}
+
+var _ PhotoSource = (*mediaRSSSource)(nil)
+
+func newMediaRSSSource(urlStr string) (PhotoSource, error) {
+	u, err := url.Parse(urlStr)
+	if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return nil, errs.Wrap(ErrInvalidSource, errs.WithCause(err), errs.WithContext("url", urlStr))
+	}
+	return &mediaRSSSource{u: u}, nil
+}
+
+// Type method returns type name of photo source (PhotoSource interface).
+func (ms *mediaRSSSource) Type() string {
+	return "mediarss"
+}
+
+// ID method returns URL of Media RSS feed (PhotoSource interface).
+func (ms *mediaRSSSource) ID() string {
+	return ms.u.String()
+}
+
+// FeedURL method returns URL of Media RSS feed (PhotoSource interface).
+func (ms *mediaRSSSource) FeedURL() string {
+	return ms.u.String()
+}
+
+// Fetch method fetches Media RSS feed with conditional request (PhotoSource interface).
+// Items without image are removed.
+func (ms *mediaRSSSource) Fetch(ctx context.Context, v *Validator) (*Metadata, *Validator, error) {
+	data, nv, err := FeedConditional(ctx, ms.u, v)
+	if err != nil {
+		return nil, nv, errs.Wrap(err)
+	}
+	items := []*Item{}
+	for _, item := range data.Items {
+		if len(item.Images) == 0 {
+			img := item.FindImage()
+			if img == nil {
+				continue
+			}
+			item.Images = []*Image{img}
+		}
+		if len(item.Authors) == 0 {
+			item.Authors = data.Authors
+		}
+		fillImages(item)
+		items = append(items, item)
+	}
+	data.Items = items
+	return data, nv, nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/url"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/goark/errs"
@@ -45,23 +46,151 @@ func (i *Image) FileName() string {
 
 // Item is item data in Metadata.
 type Item struct {
-	Title       string     `json:"title,omitempty"`
-	Description string     `json:"description,omitempty"`
-	Link        string     `json:"link,omitempty"`
-	Authors     []*Author  `json:"authors,omitempty"`
-	Published   *time.Time `json:"published,omitempty"`
-	Updated     *time.Time `json:"Updated,omitempty"`
-	Images      []*Image   `json:"images,omitempty"`
+	GUID        string       `json:"guid,omitempty"`
+	Title       string       `json:"title,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Content     string       `json:"content,omitempty"`
+	Link        string       `json:"link,omitempty"`
+	Authors     []*Author    `json:"authors,omitempty"`
+	Categories  []string     `json:"categories,omitempty"`
+	Published   *time.Time   `json:"published,omitempty"`
+	Updated     *time.Time   `json:"Updated,omitempty"`
+	Images      []*Image     `json:"images,omitempty"`
+	Enclosures  []*Enclosure `json:"enclosures,omitempty"`
+	Thumbnails  []*Image     `json:"thumbnails,omitempty"` // media:thumbnail in Media RSS
+	Related     []string     `json:"related,omitempty"`    // link rel="related" in Atom, external_url in JSON Feed
+	InReplyTo   []*InReplyTo `json:"in_reply_to,omitempty"`
+	Podcast     *Podcast     `json:"podcast,omitempty"`
+}
+
+// InReplyTo is thr:in-reply-to data (RFC 4685) in Item.
+type InReplyTo struct {
+	Ref  string `json:"ref,omitempty"`
+	Href string `json:"href,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// Enclosure is enclosure data (podcast audio, image, ...) in Item.
+type Enclosure struct {
+	URL    string `json:"url,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Length int64  `json:"length,omitempty"`
+}
+
+// IsImage method returns true if Enclosure is image (by MIME type or extension of URL).
+func (e *Enclosure) IsImage() bool {
+	if e == nil || len(e.URL) == 0 {
+		return false
+	}
+	if len(e.Type) > 0 {
+		return strings.HasPrefix(strings.ToLower(e.Type), "image/")
+	}
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(path.Ext(u.Path)) {
+	case ".jpg", ".jpeg", ".png", ".gif", ".webp":
+		return true
+	}
+	return false
+}
+
+// IsHTML method returns true if Enclosure is HTML document (by MIME type or extension of URL).
+func (e *Enclosure) IsHTML() bool {
+	if e == nil || len(e.URL) == 0 {
+		return false
+	}
+	if len(e.Type) > 0 {
+		mediaType, _, _ := strings.Cut(strings.ToLower(e.Type), ";")
+		switch strings.TrimSpace(mediaType) {
+		case "text/html", "application/xhtml+xml":
+			return true
+		}
+		return false
+	}
+	u, err := url.Parse(e.URL)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(path.Ext(u.Path)) {
+	case ".html", ".htm", ".xhtml":
+		return true
+	}
+	return false
+}
+
+// FindImage method returns image for Item. It is searched in images, image enclosures and thumbnails in this order.
+// If no image, returns nil.
+func (i *Item) FindImage() *Image {
+	if i == nil {
+		return nil
+	}
+	for _, img := range i.Images {
+		if img != nil && len(img.URL) > 0 {
+			return img
+		}
+	}
+	for _, e := range i.Enclosures {
+		if e.IsImage() {
+			return &Image{MimeType: e.Type, Title: i.Title, URL: e.URL}
+		}
+	}
+	for _, img := range i.Thumbnails {
+		if img != nil && len(img.URL) > 0 {
+			return img
+		}
+	}
+	if i.Podcast != nil && len(i.Podcast.Image) > 0 {
+		return &Image{Title: i.Title, URL: i.Podcast.Image}
+	}
+	return nil
+}
+
+// PermaLink method returns link of Item. If link is empty, returns related link or URL of HTML enclosure instead.
+// Enclosures of other media (audio, video, image, ...) are not used, so returns empty string if no link.
+func (i *Item) PermaLink() string {
+	if i == nil {
+		return ""
+	}
+	if len(i.Link) > 0 {
+		return i.Link
+	}
+	for _, l := range i.Related {
+		if len(l) > 0 {
+			return l
+		}
+	}
+	for _, e := range i.Enclosures {
+		if e.IsHTML() {
+			return e.URL
+		}
+	}
+	return ""
+}
+
+// ID method returns identifier of Item (GUID, or link if GUID is empty).
+func (i *Item) ID() string {
+	if i == nil {
+		return ""
+	}
+	if len(i.GUID) > 0 {
+		return i.GUID
+	}
+	return i.Link
 }
 
 // Metadata is metadata for feed.
 type Metadata struct {
+	FeedType    string    `json:"feedType,omitempty"` // rss, atom or json
 	FeedLink    string    `json:"feedLink,omitempty"`
 	Title       string    `json:"title,omitempty"`
 	Description string    `json:"description,omitempty"`
 	Link        string    `json:"link,omitempty"`
 	ID          string    `json:"id,omitempty"`
 	Authors     []*Author `json:"authors,omitempty"`
+	Categories  []string  `json:"categories,omitempty"`
+	Image       *Image    `json:"image,omitempty"`
 	Items       []*Item   `json:"items,omitempty"`
 }
 
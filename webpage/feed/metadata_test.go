@@ -25,6 +25,50 @@ func TestImageFileName(t *testing.T) {
 	}
 }
 
+func TestItemFindImage(t *testing.T) {
+	testCases := []struct {
+		i *feed.Item
+		u string
+	}{
+		{i: &feed.Item{}, u: ""},
+		{i: &feed.Item{Images: []*feed.Image{{URL: "http://foo/image.jpg"}}, Thumbnails: []*feed.Image{{URL: "http://foo/thumb.jpg"}}}, u: "http://foo/image.jpg"},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/audio.mp3", Type: "audio/mpeg"}, {URL: "http://foo/photo.png", Type: "image/png"}}, Thumbnails: []*feed.Image{{URL: "http://foo/thumb.jpg"}}}, u: "http://foo/photo.png"},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/photo.webp"}}}, u: "http://foo/photo.webp"},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/audio.mp3"}}, Thumbnails: []*feed.Image{{URL: "http://foo/thumb.jpg"}}}, u: "http://foo/thumb.jpg"},
+	}
+
+	for _, tc := range testCases {
+		u := ""
+		if img := tc.i.FindImage(); img != nil {
+			u = img.URL
+		}
+		if u != tc.u {
+			t.Errorf("Item.FindImage() = \"%v\", want \"%v\".", u, tc.u)
+		}
+	}
+}
+
+func TestItemPermaLink(t *testing.T) {
+	testCases := []struct {
+		i *feed.Item
+		u string
+	}{
+		{i: &feed.Item{}, u: ""},
+		{i: &feed.Item{Link: "http://foo/1", Related: []string{"http://foo/related"}}, u: "http://foo/1"},
+		{i: &feed.Item{Related: []string{"", "http://foo/related"}, Enclosures: []*feed.Enclosure{{URL: "http://foo/page.html"}}}, u: "http://foo/related"},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/audio.mp3", Type: "audio/mpeg"}}}, u: ""},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/audio.mp3"}, {URL: "http://foo/photo.png"}}}, u: ""},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{nil, {URL: "http://foo/audio.mp3"}, {URL: "http://foo/page", Type: "text/html; charset=utf-8"}}}, u: "http://foo/page"},
+		{i: &feed.Item{Enclosures: []*feed.Enclosure{{URL: "http://foo/page.htm?id=1"}}}, u: "http://foo/page.htm?id=1"},
+	}
+
+	for _, tc := range testCases {
+		if u := tc.i.PermaLink(); u != tc.u {
+			t.Errorf("Item.PermaLink() = \"%v\", want \"%v\".", u, tc.u)
+		}
+	}
+}
+
 /* Copyright 2022 Spiegel
  *
  * Licensed under the Apache License, Version 2.0 (the "License");
@@ -0,0 +1,81 @@
+package feed
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	ext "github.com/mmcdole/gofeed/extensions"
+)
+
+// Podcast is podcast data (iTunes podcast namespace) in Item.
+type Podcast struct {
+	Episode     int    `json:"episode,omitempty"`
+	Season      int    `json:"season,omitempty"`
+	EpisodeType string `json:"episode_type,omitempty"` // full, trailer or bonus
+	Duration    int64  `json:"duration,omitempty"`     // seconds
+	Image       string `json:"image,omitempty"`        // artwork
+}
+
+// GetDuration method returns duration of episode.
+func (p *Podcast) GetDuration() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return time.Duration(p.Duration) * time.Second
+}
+
+// IsZero method returns true if Podcast has no data.
+func (p *Podcast) IsZero() bool {
+	return p == nil || (p.Episode == 0 && p.Season == 0 && len(p.EpisodeType) == 0 && p.Duration == 0 && len(p.Image) == 0)
+}
+
+// podcastFromITunes function returns Podcast instance from itunes extension. If no data, returns nil.
+func podcastFromITunes(it *ext.ITunesItemExtension) *Podcast {
+	if it == nil {
+		return nil
+	}
+	p := &Podcast{
+		EpisodeType: strings.TrimSpace(it.EpisodeType),
+		Duration:    parseITunesDuration(it.Duration),
+		Image:       strings.TrimSpace(it.Image),
+	}
+	p.Episode, _ = strconv.Atoi(strings.TrimSpace(it.Episode))
+	p.Season, _ = strconv.Atoi(strings.TrimSpace(it.Season))
+	if p.IsZero() {
+		return nil
+	}
+	return p
+}
+
+// parseITunesDuration function returns seconds from itunes:duration string ("HH:MM:SS", "MM:SS" or seconds).
+func parseITunesDuration(s string) int64 {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return 0
+	}
+	var sec int64
+	for _, part := range strings.Split(s, ":") {
+		n, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil || n < 0 {
+			return 0
+		}
+		sec = sec*60 + int64(n)
+	}
+	return sec
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,89 @@
+package feed_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+const testPodcastRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+<channel><title>podcast</title><link>https://example.com/</link>
+<itunes:image href="https://example.com/artwork.jpg"/>
+<item><title>episode 1</title><link>https://example.com/ep1</link>
+<enclosure url="https://example.com/ep1.mp3" length="1234" type="audio/mpeg"/>
+<itunes:episode>1</itunes:episode><itunes:season>2</itunes:season><itunes:episodeType>full</itunes:episodeType>
+<itunes:duration>1:02:03</itunes:duration><itunes:image href="https://example.com/ep1.jpg"/>
+</item>
+<item><title>episode 2</title><link>https://example.com/ep2</link><itunes:duration>754</itunes:duration></item>
+<item><title>episode 3</title><link>https://example.com/ep3</link></item>
+</channel></rss>`
+
+func TestDecodePodcast(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testPodcastRSS))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	data, _, err := feed.FeedConditional(context.Background(), u, nil)
+	if err != nil {
+		t.Fatalf("FeedConditional() error = \"%+v\", want nil.", err)
+	}
+	if data.Image == nil || data.Image.URL != "https://example.com/artwork.jpg" {
+		t.Errorf("Metadata.Image = %v, want %v.", data.Image, "https://example.com/artwork.jpg")
+	}
+	testCases := []struct {
+		episode  int
+		season   int
+		duration time.Duration
+		image    string
+		isNil    bool
+	}{
+		{episode: 1, season: 2, duration: time.Hour + 2*time.Minute + 3*time.Second, image: "https://example.com/ep1.jpg"},
+		{duration: 754 * time.Second},
+		{isNil: true},
+	}
+	if len(data.Items) != len(testCases) {
+		t.Fatalf("count of Metadata.Items = %v, want %v.", len(data.Items), len(testCases))
+	}
+	for i, tc := range testCases {
+		p := data.Items[i].Podcast
+		if tc.isNil {
+			if p != nil {
+				t.Errorf("Item.Podcast = %v, want nil.", p)
+			}
+			continue
+		}
+		if p == nil {
+			t.Errorf("Item.Podcast = nil, want not nil.")
+			continue
+		}
+		if p.Episode != tc.episode || p.Season != tc.season || p.GetDuration() != tc.duration || p.Image != tc.image {
+			t.Errorf("Item.Podcast = %+v, want {Episode:%v Season:%v Duration:%v Image:%v}.", p, tc.episode, tc.season, tc.duration, tc.image)
+		}
+	}
+	if img := data.Items[0].FindImage(); img == nil || img.URL != "https://example.com/ep1.jpg" {
+		t.Errorf("Item.FindImage() = %v, want %v.", img, "https://example.com/ep1.jpg")
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,104 @@
+package feed
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/goark/errs"
+)
+
+// PhotoSource is interface for feed of photo service (Flickr, Pixelfed, Media RSS gallery, ...).
+type PhotoSource interface {
+	// Type method returns type name of photo source.
+	Type() string
+	// ID method returns ID (user ID, account, URL, ...) in photo source.
+	ID() string
+	// FeedURL method returns URL of feed (used as key of feed state).
+	FeedURL() string
+	// Fetch method fetches feed data with conditional request, and returns validators in response.
+	// If feed is not modified, returns ErrNotModified.
+	Fetch(ctx context.Context, v *Validator) (*Metadata, *Validator, error)
+}
+
+// SourceFactory is function type for creating PhotoSource instance from ID.
+type SourceFactory func(id string) (PhotoSource, error)
+
+var (
+	sourceMu       sync.RWMutex
+	sourceRegistry = map[string]SourceFactory{}
+)
+
+// RegisterSource function registers factory of PhotoSource with type name (case-insensitive).
+func RegisterSource(typeName string, factory SourceFactory) {
+	sourceMu.Lock()
+	defer sourceMu.Unlock()
+	sourceRegistry[strings.ToLower(typeName)] = factory
+}
+
+// SourceTypes function returns type names of registered photo sources.
+func SourceTypes() []string {
+	sourceMu.RLock()
+	defer sourceMu.RUnlock()
+	types := make([]string, 0, len(sourceRegistry))
+	for t := range sourceRegistry {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// NewSource function returns PhotoSource instance from "type:id" string (e.g. "flickr:12345678@N00").
+func NewSource(spec string) (PhotoSource, error) {
+	typeName, id, ok := strings.Cut(strings.TrimSpace(spec), ":")
+	if !ok || len(typeName) == 0 || len(id) == 0 {
+		return nil, errs.Wrap(ErrInvalidSource, errs.WithContext("source", spec))
+	}
+	sourceMu.RLock()
+	factory, ok := sourceRegistry[strings.ToLower(typeName)]
+	sourceMu.RUnlock()
+	if !ok {
+		return nil, errs.Wrap(ErrInvalidSource, errs.WithContext("source", spec), errs.WithContext("types", SourceTypes()))
+	}
+	src, err := factory(id)
+	if err != nil {
+		return nil, errs.Wrap(err, errs.WithContext("source", spec))
+	}
+	return src, nil
+}
+
+// fillImages function sets title and taken time (published time of item) to images in item if not set.
+func fillImages(item *Item) {
+	for _, img := range item.Images {
+		if len(img.Title) == 0 {
+			img.Title = item.Title
+		}
+		if img.Taken == nil && item.Published != nil {
+			taken := *item.Published
+			img.Taken = &taken
+		}
+	}
+}
+
+func init() {
+	RegisterSource("flickr", newFlickrSource)
+	RegisterSource("activitypub", newActivityPubSource)
+	RegisterSource("pixelfed", newActivityPubSource)
+	RegisterSource("mediarss", newMediaRSSSource)
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,185 @@
+package feed_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+func TestNewSource(t *testing.T) {
+	testCases := []struct {
+		spec    string
+		typ     string
+		feedURL string
+		err     error
+	}{
+		{spec: "flickr:12345678@N00", typ: "flickr", feedURL: "https://www.flickr.com/services/feeds/photos_public.gne?format=atom&id=12345678%40N00", err: nil},
+		{spec: "Pixelfed:alice@pixelfed.example", typ: "activitypub", feedURL: "https://pixelfed.example/users/alice/outbox", err: nil},
+		{spec: "activitypub:https://example.com/users/bob/outbox", typ: "activitypub", feedURL: "https://example.com/users/bob/outbox", err: nil},
+		{spec: "mediarss:https://example.com/gallery.rss", typ: "mediarss", feedURL: "https://example.com/gallery.rss", err: nil},
+		{spec: "flickr", err: feed.ErrInvalidSource},
+		{spec: "unknown:foo", err: feed.ErrInvalidSource},
+		{spec: "pixelfed:alice", err: feed.ErrInvalidSource},
+		{spec: "mediarss:gallery.rss", err: feed.ErrInvalidSource},
+	}
+	for _, tc := range testCases {
+		src, err := feed.NewSource(tc.spec)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("NewSource(%v) error = \"%+v\", want \"%+v\".", tc.spec, err, tc.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if src.Type() != tc.typ || src.FeedURL() != tc.feedURL {
+			t.Errorf("NewSource(%v) = %v (%v), want %v (%v).", tc.spec, src.Type(), src.FeedURL(), tc.typ, tc.feedURL)
+		}
+	}
+}
+
+const testOutbox = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "OrderedCollection",
+  "totalItems": 2,
+  "first": "/users/alice/outbox?page=1"
+}`
+
+const testOutboxPage = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "type": "OrderedCollectionPage",
+  "orderedItems": [
+    {
+      "type": "Create",
+      "actor": "https://pixelfed.example/users/alice",
+      "object": {
+        "id": "https://pixelfed.example/p/alice/1",
+        "type": "Note",
+        "url": "https://pixelfed.example/p/alice/1",
+        "content": "<p>Orion nebula<br />taken last night <a href=\"https://pixelfed.example/discover/tags/astrophoto\">#astrophoto</a></p>",
+        "published": "2026-01-02T03:04:05Z",
+        "attributedTo": "https://pixelfed.example/users/alice",
+        "attachment": [{"type": "Document", "mediaType": "image/jpeg", "url": "https://pixelfed.example/storage/1.jpg", "name": "Orion nebula (M42)"}],
+        "tag": [{"type": "Hashtag", "name": "#astrophoto"}]
+      }
+    },
+    {
+      "type": "Create",
+      "object": {"id": "https://pixelfed.example/p/alice/2", "type": "Note", "content": "<p>text only</p>"}
+    },
+    {
+      "type": "Announce",
+      "object": "https://example.com/notes/3"
+    }
+  ]
+}`
+
+func TestActivityPubSource(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/alice/outbox", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") == "" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/activity+json")
+		if r.URL.Query().Get("page") == "1" {
+			_, _ = w.Write([]byte(testOutboxPage))
+			return
+		}
+		_, _ = w.Write([]byte(testOutbox))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	src, err := feed.NewSource("pixelfed:" + server.URL + "/users/alice/outbox")
+	if err != nil {
+		t.Fatalf("NewSource() error = \"%+v\", want nil.", err)
+	}
+	data, _, err := src.Fetch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("PhotoSource.Fetch() error = \"%+v\", want nil.", err)
+	}
+	if len(data.Items) != 1 {
+		t.Fatalf("count of Metadata.Items = %v, want %v.", len(data.Items), 1)
+	}
+	item := data.Items[0]
+	if item.Title != "Orion nebula" {
+		t.Errorf("Item.Title = %v, want %v.", item.Title, "Orion nebula")
+	}
+	if item.Link != "https://pixelfed.example/p/alice/1" {
+		t.Errorf("Item.Link = %v, want %v.", item.Link, "https://pixelfed.example/p/alice/1")
+	}
+	if len(item.Categories) != 1 || item.Categories[0] != "astrophoto" {
+		t.Errorf("Item.Categories = %v, want %v.", item.Categories, []string{"astrophoto"})
+	}
+	if len(item.Authors) != 1 || item.Authors[0].URL != "https://pixelfed.example/users/alice" {
+		t.Errorf("Item.Authors = %v, want %v.", item.Authors, "https://pixelfed.example/users/alice")
+	}
+	if len(item.Images) != 1 {
+		t.Fatalf("count of Item.Images = %v, want %v.", len(item.Images), 1)
+	}
+	img := item.Images[0]
+	if img.URL != "https://pixelfed.example/storage/1.jpg" || img.MimeType != "image/jpeg" || img.Title != "Orion nebula (M42)" || img.Taken == nil {
+		t.Errorf("Item.Images[0] = %+v, want url, MIME type, title and taken time.", img)
+	}
+}
+
+const testGalleryRSS = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+<channel><title>gallery</title><link>https://example.com/</link>
+<item><title>photo 1</title><link>https://example.com/1</link><pubDate>Fri, 02 Jan 2026 03:04:05 GMT</pubDate>
+<media:content url="https://example.com/1.jpg" type="image/jpeg" medium="image"/></item>
+<item><title>photo 2</title><link>https://example.com/2</link><media:thumbnail url="https://example.com/2-thumb.jpg"/></item>
+<item><title>text</title><link>https://example.com/3</link></item>
+</channel></rss>`
+
+func TestMediaRSSSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testGalleryRSS))
+	}))
+	defer server.Close()
+
+	src, err := feed.NewSource("mediarss:" + server.URL)
+	if err != nil {
+		t.Fatalf("NewSource() error = \"%+v\", want nil.", err)
+	}
+	data, _, err := src.Fetch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("PhotoSource.Fetch() error = \"%+v\", want nil.", err)
+	}
+	testCases := []struct {
+		url      string
+		mimeType string
+		taken    bool
+	}{
+		{url: "https://example.com/1.jpg", mimeType: "image/jpeg", taken: true},
+		{url: "https://example.com/2-thumb.jpg", mimeType: "", taken: false},
+	}
+	if len(data.Items) != len(testCases) {
+		t.Fatalf("count of Metadata.Items = %v, want %v.", len(data.Items), len(testCases))
+	}
+	for i, tc := range testCases {
+		img := data.Items[i].Images[0]
+		if img.URL != tc.url || img.MimeType != tc.mimeType || (img.Taken != nil) != tc.taken {
+			t.Errorf("Item.Images[0] = %+v, want url=%v mime_type=%v taken=%v.", img, tc.url, tc.mimeType, tc.taken)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,86 @@
+package feed
+
+import (
+	"bytes"
+
+	"github.com/mmcdole/gofeed/atom"
+	ext "github.com/mmcdole/gofeed/extensions"
+	jsonfeed "github.com/mmcdole/gofeed/json"
+)
+
+// inReplyTo function returns thr:in-reply-to elements (Atom Threading Extensions, RFC 4685).
+func inReplyTo(exts ext.Extensions) []*InReplyTo {
+	thr, ok := exts["thr"]
+	if !ok {
+		return nil
+	}
+	var list []*InReplyTo
+	for _, e := range thr["in-reply-to"] {
+		if r := (&InReplyTo{Ref: e.Attrs["ref"], Href: e.Attrs["href"], Type: e.Attrs["type"]}); len(r.Ref) > 0 || len(r.Href) > 0 {
+			list = append(list, r)
+		}
+	}
+	return list
+}
+
+// setAtomLinks function sets related links in Atom entries to items. Items must be translated from the same feed in the same order.
+func setAtomLinks(b []byte, items []*Item) {
+	f, err := (&atom.Parser{}).Parse(bytes.NewReader(b))
+	if err != nil {
+		return
+	}
+	for i, entry := range f.Entries {
+		if i >= len(items) {
+			break
+		}
+		for _, l := range entry.Links {
+			if l != nil && l.Rel == "related" && len(l.Href) > 0 {
+				items[i].Related = append(items[i].Related, l.Href)
+			}
+		}
+	}
+}
+
+// setJSONFeedData function sets external URL and duration of attachment in JSON Feed items to items. Items must be translated from the same feed in the same order.
+func setJSONFeedData(b []byte, items []*Item) {
+	f, err := (&jsonfeed.Parser{}).Parse(bytes.NewReader(b))
+	if err != nil {
+		return
+	}
+	for i, it := range f.Items {
+		if i >= len(items) {
+			break
+		}
+		if len(it.ExternalURL) > 0 {
+			items[i].Related = append(items[i].Related, it.ExternalURL)
+		}
+		if it.Attachments == nil {
+			continue
+		}
+		for _, a := range *it.Attachments {
+			if a.DurationInSeconds > 0 {
+				if items[i].Podcast == nil {
+					items[i].Podcast = &Podcast{}
+				}
+				if items[i].Podcast.Duration == 0 {
+					items[i].Podcast.Duration = a.DurationInSeconds
+				}
+			}
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,115 @@
+package feed_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+const testAtomThread = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom" xmlns:thr="http://purl.org/syndication/thread/1.0">
+<title>test</title><id>urn:test</id><updated>2026-01-02T03:04:05Z</updated>
+<entry><title>reply</title><id>urn:test:2</id><updated>2026-01-02T03:04:05Z</updated>
+<link rel="alternate" href="https://example.com/2"/>
+<link rel="related" href="https://example.org/related"/>
+<thr:in-reply-to ref="urn:test:1" href="https://example.com/1" type="text/html"/>
+</entry>
+</feed>`
+
+const testJSONFeed = `{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "test",
+  "home_page_url": "https://example.com/",
+  "authors": [{"name": "alice"}],
+  "items": [
+    {
+      "id": "1",
+      "content_text": "hello",
+      "external_url": "https://example.org/external",
+      "image": "https://example.com/1.png",
+      "tags": ["foo"],
+      "date_published": "2026-01-02T03:04:05Z",
+      "attachments": [{"url": "https://example.com/1.mp3", "mime_type": "audio/mpeg", "size_in_bytes": 1234, "duration_in_seconds": 60}]
+    }
+  ]
+}`
+
+func TestDecodeAtomThread(t *testing.T) {
+	data := decodeTestFeed(t, testAtomThread)
+	if data.FeedType != "atom" {
+		t.Errorf("Metadata.FeedType = %v, want %v.", data.FeedType, "atom")
+	}
+	item := data.Items[0]
+	if len(item.Related) != 1 || item.Related[0] != "https://example.org/related" {
+		t.Errorf("Item.Related = %v, want %v.", item.Related, []string{"https://example.org/related"})
+	}
+	if len(item.InReplyTo) != 1 || item.InReplyTo[0].Ref != "urn:test:1" || item.InReplyTo[0].Href != "https://example.com/1" {
+		t.Errorf("Item.InReplyTo = %v, want ref=%v href=%v.", item.InReplyTo, "urn:test:1", "https://example.com/1")
+	}
+	if item.PermaLink() != "https://example.com/2" {
+		t.Errorf("Item.PermaLink() = %v, want %v.", item.PermaLink(), "https://example.com/2")
+	}
+}
+
+func TestDecodeJSONFeed(t *testing.T) {
+	data := decodeTestFeed(t, testJSONFeed)
+	if data.FeedType != "json" {
+		t.Errorf("Metadata.FeedType = %v, want %v.", data.FeedType, "json")
+	}
+	if len(data.Authors) != 1 || data.Authors[0].Name != "alice" {
+		t.Errorf("Metadata.Authors = %v, want %v.", data.Authors, "alice")
+	}
+	item := data.Items[0]
+	if item.PermaLink() != "https://example.org/external" {
+		t.Errorf("Item.PermaLink() = %v, want %v.", item.PermaLink(), "https://example.org/external")
+	}
+	if item.Published == nil {
+		t.Errorf("Item.Published = nil, want not nil.")
+	}
+	if img := item.FindImage(); img == nil || img.URL != "https://example.com/1.png" {
+		t.Errorf("Item.FindImage() = %v, want %v.", img, "https://example.com/1.png")
+	}
+	if len(item.Enclosures) != 1 || item.Enclosures[0].Type != "audio/mpeg" {
+		t.Errorf("Item.Enclosures = %v, want 1 audio enclosure.", item.Enclosures)
+	}
+	if item.Podcast == nil || item.Podcast.Duration != 60 {
+		t.Errorf("Item.Podcast = %v, want duration %v.", item.Podcast, 60)
+	}
+}
+
+func decodeTestFeed(t *testing.T, body string) *feed.Metadata {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+	u, _ := url.Parse(server.URL)
+
+	data, _, err := feed.FeedConditional(context.Background(), u, nil)
+	if err != nil {
+		t.Fatalf("FeedConditional() error = \"%+v\", want nil.", err)
+	}
+	if len(data.Items) == 0 {
+		t.Fatalf("count of Metadata.Items = 0, want >0.")
+	}
+	return data
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
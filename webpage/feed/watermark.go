@@ -0,0 +1,93 @@
+package feed
+
+import "time"
+
+// Watermark is position of items already seen in feed.
+type Watermark struct {
+	GUID      string     `json:"guid,omitempty"`
+	Published *time.Time `json:"published,omitempty"`
+}
+
+// IsZero method returns true if Watermark has no position (first subscription).
+func (w *Watermark) IsZero() bool {
+	return w == nil || (len(w.GUID) == 0 && w.Published == nil)
+}
+
+// NewItems method returns items newer than Watermark.
+// Items with published time are compared by the time, and other items are compared by position of GUID
+// (items are assumed to be in order of newest first).
+func (w *Watermark) NewItems(items []*Item) []*Item {
+	if w.IsZero() {
+		return items
+	}
+	pos := -1
+	if len(w.GUID) > 0 {
+		for i, item := range items {
+			if item.ID() == w.GUID {
+				pos = i
+				break
+			}
+		}
+	}
+	list := []*Item{}
+	for i, item := range items {
+		switch {
+		case item == nil:
+		case len(w.GUID) > 0 && item.ID() == w.GUID:
+		case item.Published != nil && w.Published != nil:
+			if item.Published.After(*w.Published) {
+				list = append(list, item)
+			}
+		case pos >= 0:
+			if i < pos {
+				list = append(list, item)
+			}
+		default:
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Next method returns new Watermark moved to the latest item in items.
+func (w *Watermark) Next(items []*Item) *Watermark {
+	next := &Watermark{}
+	if w != nil {
+		*next = *w
+	}
+	var latest *Item
+	for _, item := range items {
+		if item == nil || item.Published == nil {
+			continue
+		}
+		if latest == nil || item.Published.After(*latest.Published) {
+			latest = item
+		}
+	}
+	switch {
+	case latest != nil:
+		if next.Published == nil || latest.Published.After(*next.Published) {
+			tm := *latest.Published
+			next.Published = &tm
+			next.GUID = latest.ID()
+		}
+	case len(items) > 0 && items[0] != nil:
+		next.GUID = items[0].ID()
+	}
+	return next
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -0,0 +1,70 @@
+package feed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/goark/toolbox/webpage/feed"
+)
+
+func timePtr(tm time.Time) *time.Time {
+	return &tm
+}
+
+func TestWatermarkNewItems(t *testing.T) {
+	t1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	t3 := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	dated := []*feed.Item{
+		{GUID: "3", Link: "https://example.com/3", Published: timePtr(t3)},
+		{GUID: "2", Link: "https://example.com/2-changed", Published: timePtr(t2)},
+		{GUID: "1", Link: "https://example.com/1", Published: timePtr(t1)},
+	}
+	undated := []*feed.Item{
+		{Link: "https://example.com/c"},
+		{Link: "https://example.com/b"},
+		{Link: "https://example.com/a"},
+	}
+	testCases := []struct {
+		w     *feed.Watermark
+		items []*feed.Item
+		ids   []string
+		next  string
+	}{
+		{w: nil, items: dated, ids: []string{"3", "2", "1"}, next: "3"},
+		{w: &feed.Watermark{GUID: "2", Published: timePtr(t2)}, items: dated, ids: []string{"3"}, next: "3"},
+		{w: &feed.Watermark{GUID: "3", Published: timePtr(t3)}, items: dated, ids: []string{}, next: "3"},
+		{w: &feed.Watermark{GUID: "https://example.com/b"}, items: undated, ids: []string{"https://example.com/c"}, next: "https://example.com/c"},
+		{w: &feed.Watermark{GUID: "https://example.com/x"}, items: undated, ids: []string{"https://example.com/c", "https://example.com/b", "https://example.com/a"}, next: "https://example.com/c"},
+	}
+	for _, tc := range testCases {
+		items := tc.w.NewItems(tc.items)
+		if len(items) != len(tc.ids) {
+			t.Errorf("Watermark(%+v).NewItems() = %v items, want %v.", tc.w, len(items), len(tc.ids))
+			continue
+		}
+		for i, item := range items {
+			if item.ID() != tc.ids[i] {
+				t.Errorf("Watermark(%+v).NewItems()[%d] = %v, want %v.", tc.w, i, item.ID(), tc.ids[i])
+			}
+		}
+		if next := tc.w.Next(tc.items); next.GUID != tc.next {
+			t.Errorf("Watermark(%+v).Next() = %v, want %v.", tc.w, next.GUID, tc.next)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
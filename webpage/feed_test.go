@@ -0,0 +1,173 @@
+package webpage_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goark/toolbox/logger"
+	"github.com/goark/toolbox/webpage"
+)
+
+// testFeedRSS function returns RSS feed with n items (newest first). Items have image enclosures, so pages are not fetched.
+func testFeedRSS(prefix string, n int) string {
+	var b strings.Builder
+	b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><rss version="2.0"><channel><title>test</title><link>https://example.com/</link>`)
+	for i := n; i > 0; i-- {
+		fmt.Fprintf(&b, `<item><title>item %d</title><link>https://example.com/%s/%d</link><guid>%s-%d</guid><pubDate>Mon, %02d Jun 2026 00:00:00 GMT</pubDate><enclosure url="https://example.com/%s/%d.jpg" type="image/jpeg" length="1"/></item>`, i, prefix, i, prefix, i, i, prefix, i)
+	}
+	b.WriteString(`</channel></rss>`)
+	return b.String()
+}
+
+// runFeed function fetches feed with options of entry, saves results and returns URLs of new pages.
+func runFeed(t *testing.T, entry *webpage.FeedEntry) []string {
+	t.Helper()
+	urls, err := runFeedWithError(t, entry)
+	if err != nil {
+		t.Fatalf("Config.StopPool() error = \"%+v\", want nil.", err)
+	}
+	return urls
+}
+
+// runFeedWithError function is same as runFeed, but returns error in item pool.
+func runFeedWithError(t *testing.T, entry *webpage.FeedEntry) ([]string, error) {
+	t.Helper()
+	ctx := context.Background()
+	cfg, err := webpage.New(ctx, testDir, logger.Nop())
+	if err != nil {
+		t.Fatalf("New() error = \"%+v\", want nil.", err)
+	}
+	cfg.CreatePool()
+	if err := cfg.FeedEntry(ctx, entry); err != nil {
+		t.Fatalf("Config.FeedEntry() error = \"%+v\", want nil.", err)
+	}
+	pages, errPool := cfg.StopPool()
+	if err := cfg.Save(ctx, pages); err != nil {
+		t.Fatalf("Config.Save() error = \"%+v\", want nil.", err)
+	}
+	urls := []string{}
+	for _, page := range pages {
+		urls = append(urls, page.URL)
+	}
+	sort.Strings(urls)
+	return urls, errPool
+}
+
+func TestFeedEntryMaxItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testFeedRSS("max-items", 5)))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		maxItems int
+		want     []string
+	}{
+		{maxItems: 2, want: []string{"https://example.com/max-items/1", "https://example.com/max-items/2"}},
+		{maxItems: 0, want: []string{"https://example.com/max-items/3", "https://example.com/max-items/4", "https://example.com/max-items/5"}},
+		{maxItems: 0, want: []string{}},
+	}
+	for i, tc := range testCases {
+		got := runFeed(t, &webpage.FeedEntry{URL: server.URL + "/feed.xml", MaxItems: tc.maxItems})
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Errorf("run %d: new pages = %v, want %v.", i+1, got, tc.want)
+		}
+	}
+}
+
+func TestFeedRetryFailedItem(t *testing.T) {
+	var failed atomic.Bool
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed.xml":
+			var b strings.Builder
+			b.WriteString(`<?xml version="1.0" encoding="UTF-8"?><rss version="2.0"><channel><title>test</title><link>https://example.com/</link>`)
+			for i := 3; i > 0; i-- {
+				fmt.Fprintf(&b, `<item><title>item %d</title><link>%s/retry/%d</link><guid>retry-%d</guid><pubDate>Mon, %02d Jun 2026 00:00:00 GMT</pubDate></item>`, i, server.URL, i, i, i)
+			}
+			b.WriteString(`</channel></rss>`)
+			_, _ = w.Write([]byte(b.String()))
+		case "/retry/2":
+			if !failed.Swap(true) {
+				http.Error(w, "temporary error", http.StatusInternalServerError)
+				return
+			}
+			fallthrough
+		default:
+			_, _ = w.Write([]byte(`<html><head><title>page</title><meta property="og:image" content="https://example.com/image.jpg"></head></html>`))
+		}
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		want    []string
+		wantErr bool
+	}{
+		{want: []string{server.URL + "/retry/1", server.URL + "/retry/3"}, wantErr: true},
+		{want: []string{server.URL + "/retry/2"}},
+		{want: []string{}},
+	}
+	for i, tc := range testCases {
+		got, err := runFeedWithError(t, &webpage.FeedEntry{URL: server.URL + "/feed.xml"})
+		if (err != nil) != tc.wantErr {
+			t.Errorf("run %d: error = \"%+v\", want error %v.", i+1, err, tc.wantErr)
+		}
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Errorf("run %d: new pages = %v, want %v.", i+1, got, tc.want)
+		}
+	}
+}
+
+func TestFeedEnclosureOnlyItems(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, ".xml") {
+			_, _ = w.Write([]byte(`<html><head><title>episode</title></head></html>`))
+			return
+		}
+		_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd"><channel><title>podcast</title><link>https://example.com/</link>
+<itunes:image href="https://example.com/podcast/cover.jpg"/>
+<item><title>episode 3</title><guid>episode-3</guid><pubDate>Wed, 03 Jun 2026 00:00:00 GMT</pubDate><enclosure url="https://example.com/podcast/3.mp3" type="audio/mpeg" length="1"/></item>
+<item><title>episode 2</title><guid>episode-2</guid><pubDate>Tue, 02 Jun 2026 00:00:00 GMT</pubDate><enclosure url="http://` + r.Host + `/podcast/2.html" type="text/html" length="1"/><itunes:image href="https://example.com/podcast/2.jpg"/></item>
+<item><title>episode 1</title><guid>episode-1</guid><pubDate>Mon, 01 Jun 2026 00:00:00 GMT</pubDate><enclosure url="http://` + r.Host + `/podcast/1.html" type="text/html" length="1"/><enclosure url="https://example.com/podcast/1.mp3" type="audio/mpeg" length="1"/></item>
+</channel></rss>`))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		path string
+		want []string
+	}{
+		{path: "/podcast.xml", want: []string{server.URL + "/podcast/1.html", server.URL + "/podcast/2.html"}}, // audio only item is skipped
+		{path: "/podcast.xml", want: []string{}},
+		{path: "/mirror/podcast.xml", want: []string{}}, // new feed (no watermark), but pages are already saved
+	}
+	for i, tc := range testCases {
+		got := runFeed(t, &webpage.FeedEntry{URL: server.URL + tc.path})
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Errorf("run %d: new pages = %v, want %v.", i+1, got, tc.want)
+		}
+	}
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
@@ -4,8 +4,10 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/goark/errs"
+	"github.com/goark/toolbox/ecode"
 	"github.com/goark/toolbox/webpage/feed"
 )
 
@@ -13,15 +15,17 @@ type itemPool struct {
 	wg      sync.WaitGroup
 	pool    *infoPool
 	errList *errs.Errors
+	mu      sync.Mutex
+	failed  []*feed.Item
 }
 
 func newItemPool() *itemPool {
-	pool := &itemPool{pool: newPool(), errList: &errs.Errors{}}
+	pool := &itemPool{pool: newPool(), errList: &errs.Errors{}, failed: []*feed.Item{}}
 	pool.pool.start()
 	return pool
 }
 
-func (ip *itemPool) putFeedItem(ctx context.Context, item *feed.Item) {
+func (ip *itemPool) putFeedItem(ctx context.Context, item *feed.Item, entry *FeedEntry) {
 	if ip == nil {
 		return
 	}
@@ -31,12 +35,33 @@ func (ip *itemPool) putFeedItem(ctx context.Context, item *feed.Item) {
 		page, err := convWebpageFromFeedItem(ctx, item)
 		if err != nil {
 			ip.errList.Add(err)
+			ip.fail(item)
 			return
 		}
+		page.feedEntry = entry
 		ip.putPage(page)
 	}()
 }
 
+// fail method records feed item which is failed to convert to web page.
+func (ip *itemPool) fail(item *feed.Item) {
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	ip.failed = append(ip.failed, item)
+}
+
+// failedItems method returns feed items which are failed to convert to web page.
+func (ip *itemPool) failedItems() []*feed.Item {
+	if ip == nil {
+		return []*feed.Item{}
+	}
+	ip.mu.Lock()
+	defer ip.mu.Unlock()
+	cpy := make([]*feed.Item, len(ip.failed))
+	copy(cpy, ip.failed)
+	return cpy
+}
+
 func (ip *itemPool) putPage(page *Webpage) {
 	if ip == nil {
 		return
@@ -72,20 +97,26 @@ const (
 
 func convWebpageFromFeedItem(ctx context.Context, item *feed.Item) (*Webpage, error) {
 	page := &Webpage{
-		URL:         item.Link,
+		URL:         item.PermaLink(),
 		Title:       item.Title,
 		Description: item.Description,
 		Published:   item.Published,
 	}
-	if len(item.Images) > 0 {
-		page.ImageURL = item.Images[0].URL
+	now := time.Now()
+	page.FetchedAt = &now
+	if img := item.FindImage(); img != nil {
+		page.ImageURL = img.URL
+		page.ImageAlt = img.Title
+	}
+	if len(page.URL) == 0 {
+		return nil, errs.Wrap(ecode.ErrNoLink, errs.WithContext("guid", item.GUID))
 	}
-	if len(page.ImageURL) == 0 || strings.Contains(item.Link, githubDomainInURL) {
+	if len(page.ImageURL) == 0 || strings.Contains(page.URL, githubDomainInURL) {
 		i, err := ReadPage(ctx, page.URL)
 		if err != nil {
 			return nil, errs.Wrap(err, errs.WithContext("url", page.URL))
 		}
-		if strings.Contains(item.Link, githubDomainInURL) {
+		if strings.Contains(page.URL, githubDomainInURL) {
 			page.Title = i.Title
 		}
 		if len(page.ImageURL) == 0 {
@@ -28,14 +28,18 @@ func (cfg *Config) GetWebpage(ctx context.Context, urlStr string) (*Webpage, boo
 	if page, err := cfg.find(ctx, urlStr); err != nil {
 		return nil, false, errs.Wrap(err, errs.WithContext("url", urlStr))
 	} else if page != nil {
-		cfg.Logger().Debug("get webpage data from database", zap.Any("webpage", page))
-		return page, true, nil
+		if !cfg.refresh || !page.Expired(cfg.cacheTTL) {
+			cfg.Logger().Debug("get webpage data from database", zap.Any("webpage", page))
+			return page, true, nil
+		}
+		cfg.Logger().Debug("refresh stale webpage data", zap.Any("webpage", page))
 	}
 	// fetch webpage.
 	page, err := ReadPage(ctx, urlStr)
 	if err != nil {
 		return nil, false, errs.Wrap(err, errs.WithContext("url", urlStr))
 	}
+	cfg.cacheData.Put(page)
 	cfg.Logger().Debug("fetch webpage data", zap.Any("webpage", page))
 	return page, false, nil
 }
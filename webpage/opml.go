@@ -0,0 +1,139 @@
+package webpage
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/goark/errs"
+	"github.com/goark/toolbox/consts"
+)
+
+type opml struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    opmlHead `xml:"head"`
+	Body    opmlBody `xml:"body"`
+}
+
+type opmlHead struct {
+	Title       string `xml:"title,omitempty"`
+	DateCreated string `xml:"dateCreated,omitempty"`
+}
+
+type opmlBody struct {
+	Outlines []*opmlOutline `xml:"outline"`
+}
+
+type opmlOutline struct {
+	Text     string         `xml:"text,attr"`
+	Title    string         `xml:"title,attr,omitempty"`
+	Type     string         `xml:"type,attr,omitempty"`
+	XMLURL   string         `xml:"xmlUrl,attr,omitempty"`
+	HTMLURL  string         `xml:"htmlUrl,attr,omitempty"`
+	Category string         `xml:"category,attr,omitempty"`
+	Outlines []*opmlOutline `xml:"outline"`
+}
+
+func (o *opmlOutline) name() string {
+	if len(o.Title) > 0 {
+		return o.Title
+	}
+	return o.Text
+}
+
+// DecodeOPML function decodes OPML to FeedList.
+// Names of outline groups and category attributes are imported as tags.
+func DecodeOPML(r io.Reader) (FeedList, error) {
+	var doc opml
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return FeedList{}, errs.Wrap(err)
+	}
+	return importOutlines(FeedList{}, doc.Body.Outlines, nil), nil
+}
+
+func importOutlines(fl FeedList, outlines []*opmlOutline, groups []string) FeedList {
+	for _, o := range outlines {
+		if o == nil {
+			continue
+		}
+		if len(o.XMLURL) == 0 {
+			// outline group
+			fl = importOutlines(fl, o.Outlines, append(groups[:len(groups):len(groups)], o.name()))
+			continue
+		}
+		entry := &FeedEntry{URL: strings.TrimSpace(o.XMLURL), Title: o.name()}
+		entry.addTags(groups...)
+		for _, c := range strings.Split(o.Category, ",") {
+			entry.addTags(strings.Trim(strings.TrimSpace(c), "/"))
+		}
+		fl = fl.add(entry)
+	}
+	return fl
+}
+
+// EncodeOPML method encodes FeedList to OPML.
+// Feeds are grouped by the first tag, and all tags are exported as category attribute.
+func (fl FeedList) EncodeOPML(w io.Writer, title string) error {
+	if len(title) == 0 {
+		title = consts.AppNameShort + " feed list"
+	}
+	doc := &opml{
+		Version: "2.0",
+		Head:    opmlHead{Title: title, DateCreated: time.Now().Format(time.RFC1123Z)},
+	}
+	groups := map[string]*opmlOutline{}
+	for _, entry := range fl {
+		text := entry.Title
+		if len(text) == 0 {
+			text = entry.URL
+		}
+		o := &opmlOutline{Text: text, Title: entry.Title, Type: "rss", XMLURL: entry.URL}
+		if len(entry.Tags) > 1 {
+			cats := make([]string, 0, len(entry.Tags))
+			for _, tag := range entry.Tags {
+				cats = append(cats, "/"+tag)
+			}
+			o.Category = strings.Join(cats, ",")
+		}
+		if len(entry.Tags) == 0 {
+			doc.Body.Outlines = append(doc.Body.Outlines, o)
+			continue
+		}
+		group, ok := groups[entry.Tags[0]]
+		if !ok {
+			group = &opmlOutline{Text: entry.Tags[0], Title: entry.Tags[0]}
+			groups[entry.Tags[0]] = group
+			doc.Body.Outlines = append(doc.Body.Outlines, group)
+		}
+		group.Outlines = append(group.Outlines, o)
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errs.Wrap(err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return errs.Wrap(err)
+	}
+	return nil
+}
+
+/* Copyright 2026 Spiegel
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * 	http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
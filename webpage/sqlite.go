@@ -36,9 +36,14 @@ func (cfg *Config) saveDB(ctx context.Context, list []*Webpage) error {
 		data = append(data, exportWebpageToModel(d))
 	}
 	cfg.Logger().Debug("start saving data to database", zap.Any("data", data))
-	if err := cfg.repos.InsertWebpage(ctx, data); err != nil {
+	res, err := cfg.repos.UpsertWebpages(ctx, data)
+	if err != nil {
 		return errs.Wrap(err)
 	}
+	cfg.Logger().Info("saved webpage data", zap.Int("inserted", res.Inserted), zap.Int("updated", res.Updated), zap.Int("skipped", res.Skipped))
+	for _, page := range list {
+		cfg.cacheData.Put(page)
+	}
 	cfg.Logger().Debug("complete saving data to database")
 	return nil
 }
@@ -51,6 +56,7 @@ func importWebpageFromModel(data model.Webpage) *Webpage {
 		Description: data.Description,
 		ImageURL:    data.ImageURL,
 		Published:   data.GetPublished(),
+		FetchedAt:   data.GetFetchedAt(),
 	}
 }
 
@@ -66,6 +72,7 @@ func exportWebpageToModel(page *Webpage) model.Webpage {
 		ImageURL:    page.ImageURL,
 	}
 	data.SetPublished(page.Published)
+	data.SetFetchedAt(page.FetchedAt)
 	return data
 }
 
@@ -16,6 +16,7 @@ import (
 	"github.com/goark/errs"
 	"github.com/goark/fetch"
 	"github.com/goark/toolbox/ecode"
+	"github.com/goark/toolbox/webpage/feed"
 	"github.com/mattn/go-encoding"
 	"golang.org/x/net/html/charset"
 )
@@ -27,42 +28,42 @@ type Webpage struct {
 	Title       string     `json:"title,omitempty"`
 	Description string     `json:"description,omitempty"`
 	ImageURL    string     `json:"image_url,omitempty"`
+	ImageAlt    string     `json:"image_alt,omitempty"`
 	Published   *time.Time `json:"published,omitempty"`
+	FetchedAt   *time.Time `json:"fetched_at,omitempty"`
+	feedEntry   *FeedEntry
 }
 
-// ReadPage function reads web page from URL, and analysis information.
-func ReadPage(ctx context.Context, urlStr string) (*Webpage, error) {
-	// fetch web page
-	u, err := fetch.URL(urlStr)
-	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", urlStr))
-	}
-	resp, err := fetch.New().GetWithContext(ctx, u)
-	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+// FeedEntry method returns entry of feed list which the web page is found in (nil if not found in feed list).
+func (wp *Webpage) FeedEntry() *FeedEntry {
+	if wp == nil {
+		return nil
 	}
-	defer resp.Close()
+	return wp.feedEntry
+}
 
-	// detect character encoding
-	br := bufio.NewReader(resp.Body())
-	var r io.Reader = br
-	if data, err2 := br.Peek(1024); err2 == nil { //next 1024 bytes without advancing the reader.
-		enc, name, _ := charset.DetermineEncoding(data, resp.Header().Get("content-type"))
-		if enc != nil {
-			r = enc.NewDecoder().Reader(br)
-		} else if len(name) > 0 {
-			if enc := encoding.GetEncoding(name); enc != nil {
-				r = enc.NewDecoder().Reader(br)
-			}
-		}
+// Expired method returns true if web page data is fetched before TTL (0 is no expiration).
+// Data without fetched time is treated as expired.
+func (wp *Webpage) Expired(ttl time.Duration) bool {
+	if wp == nil || ttl <= 0 {
+		return false
 	}
+	if wp.FetchedAt == nil {
+		return true
+	}
+	return time.Since(*wp.FetchedAt) > ttl
+}
 
-	// analysis web content
-	link := &Webpage{URL: urlStr}
-	doc, err := goquery.NewDocumentFromReader(r)
+// ReadPage function reads web page from URL, and analysis information.
+func ReadPage(ctx context.Context, urlStr string) (*Webpage, error) {
+	doc, _, err := readDocument(ctx, urlStr)
 	if err != nil {
-		return nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+		return nil, errs.Wrap(err)
 	}
+
+	// analysis web content
+	now := time.Now()
+	link := &Webpage{URL: urlStr, FetchedAt: &now}
 	doc.Find("head").Each(func(_ int, s *goquery.Selection) {
 		s.Find("title").Each(func(_ int, s *goquery.Selection) {
 			t := s.Text()
@@ -99,6 +100,40 @@ func ReadPage(ctx context.Context, urlStr string) (*Webpage, error) {
 	return link, nil
 }
 
+// readDocument function fetches web page from URL, and returns HTML document with parsed URL.
+func readDocument(ctx context.Context, urlStr string) (*goquery.Document, *url.URL, error) {
+	// fetch web page
+	u, err := fetch.URL(urlStr)
+	if err != nil {
+		return nil, nil, errs.Wrap(err, errs.WithContext("url", urlStr))
+	}
+	resp, err := fetch.New(fetch.WithHTTPClient(feed.HTTPClient())).GetWithContext(ctx, u)
+	if err != nil {
+		return nil, nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	defer resp.Close()
+
+	// detect character encoding
+	br := bufio.NewReader(resp.Body())
+	var r io.Reader = br
+	if data, err2 := br.Peek(1024); err2 == nil { //next 1024 bytes without advancing the reader.
+		enc, name, _ := charset.DetermineEncoding(data, resp.Header().Get("content-type"))
+		if enc != nil {
+			r = enc.NewDecoder().Reader(br)
+		} else if len(name) > 0 {
+			if enc := encoding.GetEncoding(name); enc != nil {
+				r = enc.NewDecoder().Reader(br)
+			}
+		}
+	}
+
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, nil, errs.Wrap(err, errs.WithContext("url", u.String()))
+	}
+	return doc, u, nil
+}
+
 // SortPages function sorts Info list.
 func SortPages(webpages []*Webpage) {
 	if len(webpages) < 2 {
@@ -139,7 +174,7 @@ func (wp *Webpage) ImageFile(ctx context.Context, dir string) (string, error) {
 	if err != nil {
 		return "", errs.Wrap(err, errs.WithContext("image_url", wp.ImageURL))
 	}
-	img, err := fetch.New().GetWithContext(ctx, u)
+	img, err := fetch.New(fetch.WithHTTPClient(feed.HTTPClient())).GetWithContext(ctx, u)
 	if err != nil {
 		return "", errs.Wrap(err, errs.WithContext("image_url", wp.ImageURL))
 	}
@@ -160,6 +195,17 @@ func (wp *Webpage) ImageFile(ctx context.Context, dir string) (string, error) {
 	return tname, nil
 }
 
+// AltText method returns alt text for image of web page. If no alt text, returns title of web page.
+func (wp *Webpage) AltText() string {
+	if wp == nil {
+		return ""
+	}
+	if len(wp.ImageAlt) > 0 {
+		return wp.ImageAlt
+	}
+	return wp.Title
+}
+
 func (wp *Webpage) MakeMessage(prefixMsg string) string {
 	if wp == nil {
 		return ""